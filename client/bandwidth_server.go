@@ -0,0 +1,52 @@
+package client
+
+// BandwidthServer defines /tool/bandwidth-server settings
+type BandwidthServer struct {
+	AllocateUdpPortsFrom int  `mikrotik:"allocate-udp-ports-from"`
+	Authenticate         bool `mikrotik:"authenticate"`
+	Enabled              bool `mikrotik:"enabled"`
+	MaxSessions          int  `mikrotik:"max-sessions"`
+}
+
+var (
+	_ Singleton = (*BandwidthServer)(nil)
+	_ Resetter  = (*BandwidthServer)(nil)
+)
+
+func (b *BandwidthServer) ActionToCommand(action Action) string {
+	return map[Action]string{
+		Find:   "/tool/bandwidth-server/print",
+		Update: "/tool/bandwidth-server/set",
+	}[action]
+}
+
+func (b *BandwidthServer) DefaultValues() map[string]string {
+	return map[string]string{
+		"allocate-udp-ports-from": "2000",
+		"authenticate":            "yes",
+		"enabled":                 "yes",
+		"max-sessions":            "100",
+	}
+}
+
+func (client Mikrotik) FindBandwidthServer() (*BandwidthServer, error) {
+	res, err := client.FindSingleton(&BandwidthServer{})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*BandwidthServer), nil
+}
+
+func (client Mikrotik) UpdateBandwidthServer(b *BandwidthServer) (*BandwidthServer, error) {
+	res, err := client.UpdateSingleton(b)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*BandwidthServer), nil
+}
+
+func (client Mikrotik) ResetBandwidthServer() error {
+	return client.ResetSingleton(&BandwidthServer{})
+}
@@ -0,0 +1,31 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBandwidthServer_updateAndReset(t *testing.T) {
+	c := NewClient(GetConfigFromEnv())
+
+	defer func() {
+		require.NoError(t, c.ResetBandwidthServer())
+
+		found, err := c.FindBandwidthServer()
+		require.NoError(t, err)
+		assert.True(t, found.Enabled)
+		assert.Equal(t, 100, found.MaxSessions)
+	}()
+
+	updated, err := c.UpdateBandwidthServer(&BandwidthServer{
+		AllocateUdpPortsFrom: 2000,
+		Authenticate:         true,
+		Enabled:              false,
+		MaxSessions:          10,
+	})
+	require.NoError(t, err)
+	assert.False(t, updated.Enabled)
+	assert.Equal(t, 10, updated.MaxSessions)
+}
@@ -0,0 +1,114 @@
+package client
+
+import (
+	"github.com/ddelnano/terraform-provider-mikrotik/client/types"
+	"github.com/go-routeros/routeros"
+)
+
+// BgpConnection defines BGP connection in /routing/bgp/connection menu, only supported by RouterOS v7+
+//
+// It replaces BgpInstance and BgpPeer used by earlier RouterOS versions.
+// Boolean properties are optional, so unset ones are not sent and the inherited values are kept.
+type BgpConnection struct {
+	Id                 string             `mikrotik:".id" codegen:"id,mikrotikID"`
+	Name               string             `mikrotik:"name" codegen:"name,required,terraformID"`
+	AddressFamilies    types.MikrotikList `mikrotik:"address-families" codegen:"address_families"`
+	As                 int                `mikrotik:"as" codegen:"as"`
+	Comment            string             `mikrotik:"comment" codegen:"comment"`
+	Connect            *bool              `mikrotik:"connect" codegen:"connect"`
+	Disabled           *bool              `mikrotik:"disabled" codegen:"disabled"`
+	HoldTime           string             `mikrotik:"hold-time" codegen:"hold_time"`
+	InputFilter        string             `mikrotik:"input.filter" codegen:"input_filter"`
+	KeepaliveTime      string             `mikrotik:"keepalive-time" codegen:"keepalive_time"`
+	Listen             *bool              `mikrotik:"listen" codegen:"listen"`
+	LocalAddress       string             `mikrotik:"local.address" codegen:"local_address"`
+	LocalRole          string             `mikrotik:"local.role" codegen:"local_role,required"`
+	Multihop           *bool              `mikrotik:"multihop" codegen:"multihop"`
+	NexthopChoice      string             `mikrotik:"nexthop-choice" codegen:"nexthop_choice"`
+	OutputFilterChain  string             `mikrotik:"output.filter-chain" codegen:"output_filter_chain"`
+	OutputRedistribute types.MikrotikList `mikrotik:"output.redistribute" codegen:"output_redistribute"`
+	RemoteAddress      string             `mikrotik:"remote.address" codegen:"remote_address,required"`
+	RemoteAs           int                `mikrotik:"remote.as" codegen:"remote_as"`
+	RemotePort         int                `mikrotik:"remote.port" codegen:"remote_port"`
+	RouterId           string             `mikrotik:"router-id" codegen:"router_id"`
+	RoutingTable       string             `mikrotik:"routing-table" codegen:"routing_table"`
+	TcpMd5Key          string             `mikrotik:"tcp-md5-key" codegen:"tcp_md5_key"`
+	Templates          types.MikrotikList `mikrotik:"templates" codegen:"templates"`
+	UseBfd             *bool              `mikrotik:"use-bfd" codegen:"use_bfd"`
+	Vrf                string             `mikrotik:"vrf" codegen:"vrf"`
+}
+
+var _ Resource = (*BgpConnection)(nil)
+
+func (b *BgpConnection) ActionToCommand(a Action) string {
+	return map[Action]string{
+		Add:    "/routing/bgp/connection/add",
+		Find:   "/routing/bgp/connection/print",
+		Update: "/routing/bgp/connection/set",
+		Delete: "/routing/bgp/connection/remove",
+	}[a]
+}
+
+func (b *BgpConnection) IDField() string {
+	return ".id"
+}
+
+func (b *BgpConnection) ID() string {
+	return b.Id
+}
+
+func (b *BgpConnection) SetID(id string) {
+	b.Id = id
+}
+
+func (b *BgpConnection) AfterAddHook(r *routeros.Reply) {
+	b.Id = r.Done.Map["ret"]
+}
+
+func (b *BgpConnection) FindField() string {
+	return "name"
+}
+
+func (b *BgpConnection) FindFieldValue() string {
+	return b.Name
+}
+
+func (b *BgpConnection) DeleteField() string {
+	return "numbers"
+}
+
+func (b *BgpConnection) DeleteFieldValue() string {
+	return b.Name
+}
+
+// Typed wrappers
+func (c Mikrotik) AddBgpConnection(r *BgpConnection) (*BgpConnection, error) {
+	res, err := c.Add(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*BgpConnection), nil
+}
+
+func (c Mikrotik) UpdateBgpConnection(r *BgpConnection) (*BgpConnection, error) {
+	res, err := c.Update(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*BgpConnection), nil
+}
+
+func (c Mikrotik) FindBgpConnection(name string) (*BgpConnection, error) {
+	res, err := c.Find(&BgpConnection{Name: name})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*BgpConnection), nil
+}
+
+func (c Mikrotik) DeleteBgpConnection(name string) error {
+	return c.Delete(&BgpConnection{Name: name})
+}
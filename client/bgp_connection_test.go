@@ -0,0 +1,55 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBgpConnection_basic(t *testing.T) {
+	SkipIfRouterOSV6OrEarlier(t, sysResources)
+	c := NewClient(GetConfigFromEnv())
+
+	template, err := c.AddBgpTemplate(&BgpTemplate{
+		Name:            "test-template-" + RandomString(),
+		As:              65530,
+		AddressFamilies: types.MikrotikList{"ip"},
+	})
+	require.NoError(t, err)
+	defer func(name string) {
+		assert.NoError(t, c.DeleteBgpTemplate(name))
+	}(template.Name)
+
+	disabled := true
+	connection := &BgpConnection{
+		Name:          "test-connection-" + RandomString(),
+		As:            65530,
+		Disabled:      &disabled,
+		LocalRole:     "ebgp",
+		RemoteAddress: "172.21.16.1",
+		RemoteAs:      65533,
+		Templates:     types.MikrotikList{template.Name},
+	}
+	created, err := c.AddBgpConnection(connection)
+	require.NoError(t, err)
+	defer func(name string) {
+		assert.NoError(t, c.DeleteBgpConnection(name))
+	}(created.Name)
+
+	found, err := c.FindBgpConnection(connection.Name)
+	require.NoError(t, err)
+	assert.Equal(t, connection.RemoteAddress, found.RemoteAddress)
+	assert.Equal(t, connection.RemoteAs, found.RemoteAs)
+	assert.Equal(t, connection.LocalRole, found.LocalRole)
+	assert.Equal(t, connection.Templates, found.Templates)
+	assert.Equal(t, connection.Disabled, found.Disabled)
+	// unset flags are inherited from the template, so they are not reported on the connection
+	assert.Nil(t, found.Multihop)
+
+	found.RemoteAs = 65534
+	updated, err := c.UpdateBgpConnection(found)
+	require.NoError(t, err)
+	assert.Equal(t, 65534, updated.RemoteAs)
+}
@@ -0,0 +1,116 @@
+package client
+
+import (
+	"github.com/ddelnano/terraform-provider-mikrotik/client/types"
+)
+
+// BgpSession describes state of a BGP session
+//
+// RouterOS v7 lists sessions in /routing/bgp/session menu,
+// while earlier versions report the state along with peer configuration in /routing/bgp/peer menu.
+type BgpSession struct {
+	Id            string                 `mikrotik:".id"`
+	Name          string                 `mikrotik:"name"`
+	Established   bool                   `mikrotik:"established"`
+	PrefixCount   int                    `mikrotik:"prefix-count"`
+	RemoteAddress string                 `mikrotik:"remote.address"`
+	RemoteAs      int                    `mikrotik:"remote.as"`
+	RemoteId      string                 `mikrotik:"remote.id"`
+	State         string                 `mikrotik:"state"`
+	Uptime        types.MikrotikDuration `mikrotik:"uptime"`
+}
+
+var _ Resource = (*BgpSession)(nil)
+
+func (b *BgpSession) ActionToCommand(a Action) string {
+	return map[Action]string{
+		Find: "/routing/bgp/session/print",
+		List: "/routing/bgp/session/print",
+	}[a]
+}
+
+func (b *BgpSession) IDField() string {
+	return ".id"
+}
+
+func (b *BgpSession) ID() string {
+	return b.Id
+}
+
+func (b *BgpSession) SetID(id string) {
+	b.Id = id
+}
+
+// legacyBgpSession holds state of a BGP peer reported by RouterOS v6
+type legacyBgpSession struct {
+	Id            string                 `mikrotik:".id"`
+	Name          string                 `mikrotik:"name"`
+	Established   bool                   `mikrotik:"established"`
+	PrefixCount   int                    `mikrotik:"prefix-count"`
+	RemoteAddress string                 `mikrotik:"remote-address"`
+	RemoteAs      int                    `mikrotik:"remote-as"`
+	RemoteId      string                 `mikrotik:"remote-id"`
+	State         string                 `mikrotik:"state"`
+	Uptime        types.MikrotikDuration `mikrotik:"uptime"`
+}
+
+var _ Resource = (*legacyBgpSession)(nil)
+
+func (b *legacyBgpSession) ActionToCommand(a Action) string {
+	return map[Action]string{
+		Find: "/routing/bgp/peer/print",
+		List: "/routing/bgp/peer/print",
+	}[a]
+}
+
+func (b *legacyBgpSession) IDField() string {
+	return ".id"
+}
+
+func (b *legacyBgpSession) ID() string {
+	return b.Id
+}
+
+func (b *legacyBgpSession) SetID(id string) {
+	b.Id = id
+}
+
+// ListBgpSessions returns state of all BGP sessions.
+//
+// RouterOS v7 does not report the state machine status, so State is either "established" or "idle" there.
+func (c Mikrotik) ListBgpSessions() ([]BgpSession, error) {
+	res, err := c.List(&BgpSession{})
+	if legacyBgpUnsupported(err) {
+		return c.listLegacyBgpSessions()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	returnSlice := make([]BgpSession, len(res))
+	for i, v := range res {
+		returnSlice[i] = *(v.(*BgpSession))
+		if returnSlice[i].State == "" {
+			returnSlice[i].State = "idle"
+			if returnSlice[i].Established {
+				returnSlice[i].State = "established"
+			}
+		}
+	}
+
+	return returnSlice, nil
+}
+
+func (c Mikrotik) listLegacyBgpSessions() ([]BgpSession, error) {
+	res, err := c.list(&legacyBgpSession{}, "=status=")
+	if err != nil {
+		return nil, err
+	}
+
+	returnSlice := make([]BgpSession, len(res))
+	for i, v := range res {
+		returnSlice[i] = BgpSession(*(v.(*legacyBgpSession)))
+	}
+
+	return returnSlice, nil
+}
@@ -0,0 +1,77 @@
+package client
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListBgpSessions(t *testing.T) {
+	SkipIfRouterOSV6OrEarlier(t, sysResources)
+	c := NewClient(GetConfigFromEnv())
+
+	connection, err := c.AddBgpConnection(&BgpConnection{
+		Name:          "test-session-" + RandomString(),
+		As:            65530,
+		LocalRole:     "ebgp",
+		RemoteAddress: "172.21.16.1",
+		RemoteAs:      65533,
+	})
+	require.NoError(t, err)
+	defer func(name string) {
+		assert.NoError(t, c.DeleteBgpConnection(name))
+	}(connection.Name)
+
+	// RouterOS v7 starts the session shortly after the connection is added and names it after the connection
+	var found *BgpSession
+	require.Eventually(t, func() bool {
+		sessions, err := c.ListBgpSessions()
+		require.NoError(t, err)
+		for i := range sessions {
+			if strings.HasPrefix(sessions[i].Name, connection.Name) {
+				found = &sessions[i]
+				return true
+			}
+		}
+		return false
+	}, 30*time.Second, time.Second)
+
+	assert.Equal(t, "172.21.16.1", found.RemoteAddress)
+	assert.Equal(t, 65533, found.RemoteAs)
+	assert.Contains(t, []string{"established", "idle"}, found.State)
+}
+
+func TestListBgpSessions_legacy(t *testing.T) {
+	SkipIfRouterOSV7OrLater(t, sysResources)
+	c := NewClient(GetConfigFromEnv())
+
+	instanceName := "session-test-" + RandomString()
+	_, err := c.AddBgpInstance(&BgpInstance{Name: instanceName, As: 65530, RouterID: "172.16.0.254"})
+	require.NoError(t, err)
+	defer func(name string) {
+		assert.NoError(t, c.DeleteBgpInstance(name))
+	}(instanceName)
+
+	peer, err := c.AddBgpPeer(&BgpPeer{Name: instanceName, Instance: instanceName, RemoteAs: 65533, RemoteAddress: "172.21.16.1"})
+	require.NoError(t, err)
+	defer func(name string) {
+		assert.NoError(t, c.DeleteBgpPeer(name))
+	}(peer.Name)
+
+	sessions, err := c.ListBgpSessions()
+	require.NoError(t, err)
+
+	var found *BgpSession
+	for i := range sessions {
+		if sessions[i].Name == peer.Name {
+			found = &sessions[i]
+		}
+	}
+	require.NotNil(t, found)
+	assert.Equal(t, "172.21.16.1", found.RemoteAddress)
+	assert.Equal(t, 65533, found.RemoteAs)
+	assert.NotEmpty(t, found.State)
+}
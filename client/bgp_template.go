@@ -0,0 +1,105 @@
+package client
+
+import (
+	"github.com/ddelnano/terraform-provider-mikrotik/client/types"
+	"github.com/go-routeros/routeros"
+)
+
+// BgpTemplate defines BGP template in /routing/bgp/template menu, only supported by RouterOS v7+
+//
+// Templates hold settings shared by several BGP connections.
+// Boolean properties are optional, so unset ones are not sent and the inherited values are kept.
+type BgpTemplate struct {
+	Id                 string             `mikrotik:".id" codegen:"id,mikrotikID"`
+	Name               string             `mikrotik:"name" codegen:"name,required,terraformID"`
+	AddressFamilies    types.MikrotikList `mikrotik:"address-families" codegen:"address_families"`
+	As                 int                `mikrotik:"as" codegen:"as"`
+	Comment            string             `mikrotik:"comment" codegen:"comment"`
+	Disabled           *bool              `mikrotik:"disabled" codegen:"disabled"`
+	HoldTime           string             `mikrotik:"hold-time" codegen:"hold_time"`
+	InputFilter        string             `mikrotik:"input.filter" codegen:"input_filter"`
+	KeepaliveTime      string             `mikrotik:"keepalive-time" codegen:"keepalive_time"`
+	Multihop           *bool              `mikrotik:"multihop" codegen:"multihop"`
+	NexthopChoice      string             `mikrotik:"nexthop-choice" codegen:"nexthop_choice"`
+	OutputFilterChain  string             `mikrotik:"output.filter-chain" codegen:"output_filter_chain"`
+	OutputRedistribute types.MikrotikList `mikrotik:"output.redistribute" codegen:"output_redistribute"`
+	RouterId           string             `mikrotik:"router-id" codegen:"router_id"`
+	RoutingTable       string             `mikrotik:"routing-table" codegen:"routing_table"`
+	Templates          types.MikrotikList `mikrotik:"templates" codegen:"templates"`
+	UseBfd             *bool              `mikrotik:"use-bfd" codegen:"use_bfd"`
+}
+
+var _ Resource = (*BgpTemplate)(nil)
+
+func (b *BgpTemplate) ActionToCommand(a Action) string {
+	return map[Action]string{
+		Add:    "/routing/bgp/template/add",
+		Find:   "/routing/bgp/template/print",
+		Update: "/routing/bgp/template/set",
+		Delete: "/routing/bgp/template/remove",
+	}[a]
+}
+
+func (b *BgpTemplate) IDField() string {
+	return ".id"
+}
+
+func (b *BgpTemplate) ID() string {
+	return b.Id
+}
+
+func (b *BgpTemplate) SetID(id string) {
+	b.Id = id
+}
+
+func (b *BgpTemplate) AfterAddHook(r *routeros.Reply) {
+	b.Id = r.Done.Map["ret"]
+}
+
+func (b *BgpTemplate) FindField() string {
+	return "name"
+}
+
+func (b *BgpTemplate) FindFieldValue() string {
+	return b.Name
+}
+
+func (b *BgpTemplate) DeleteField() string {
+	return "numbers"
+}
+
+func (b *BgpTemplate) DeleteFieldValue() string {
+	return b.Name
+}
+
+// Typed wrappers
+func (c Mikrotik) AddBgpTemplate(r *BgpTemplate) (*BgpTemplate, error) {
+	res, err := c.Add(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*BgpTemplate), nil
+}
+
+func (c Mikrotik) UpdateBgpTemplate(r *BgpTemplate) (*BgpTemplate, error) {
+	res, err := c.Update(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*BgpTemplate), nil
+}
+
+func (c Mikrotik) FindBgpTemplate(name string) (*BgpTemplate, error) {
+	res, err := c.Find(&BgpTemplate{Name: name})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*BgpTemplate), nil
+}
+
+func (c Mikrotik) DeleteBgpTemplate(name string) error {
+	return c.Delete(&BgpTemplate{Name: name})
+}
@@ -0,0 +1,44 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBgpTemplate_basic(t *testing.T) {
+	SkipIfRouterOSV6OrEarlier(t, sysResources)
+	c := NewClient(GetConfigFromEnv())
+
+	multihop := true
+	template := &BgpTemplate{
+		Name:            "test-template-" + RandomString(),
+		As:              65530,
+		AddressFamilies: types.MikrotikList{"ip"},
+		HoldTime:        "1m",
+		Multihop:        &multihop,
+	}
+	created, err := c.AddBgpTemplate(template)
+	require.NoError(t, err)
+	defer func(name string) {
+		assert.NoError(t, c.DeleteBgpTemplate(name))
+	}(created.Name)
+
+	found, err := c.FindBgpTemplate(template.Name)
+	require.NoError(t, err)
+	assert.Equal(t, template.As, found.As)
+	assert.Equal(t, template.AddressFamilies, found.AddressFamilies)
+	assert.Equal(t, template.HoldTime, found.HoldTime)
+	assert.Equal(t, template.Multihop, found.Multihop)
+
+	multihop = false
+	found.As = 65531
+	found.Multihop = &multihop
+	updated, err := c.UpdateBgpTemplate(found)
+	require.NoError(t, err)
+	assert.Equal(t, 65531, updated.As)
+	require.NotNil(t, updated.Multihop)
+	assert.False(t, *updated.Multihop)
+}
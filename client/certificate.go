@@ -0,0 +1,289 @@
+package client
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client/types"
+	"github.com/go-routeros/routeros"
+)
+
+// Certificate defines certificate in /certificate menu
+//
+// A certificate is either added as a template and signed on the router afterwards,
+// or imported from PEM encoded files.
+type Certificate struct {
+	Id             string             `mikrotik:".id" codegen:"id,mikrotikID"`
+	Name           string             `mikrotik:"name" codegen:"name,terraformID,required"`
+	CommonName     string             `mikrotik:"common-name" codegen:"common_name"`
+	Country        string             `mikrotik:"country" codegen:"country"`
+	DaysValid      int                `mikrotik:"days-valid" codegen:"days_valid"`
+	Fingerprint    string             `mikrotik:"fingerprint,readonly" codegen:"fingerprint,computed"`
+	InvalidAfter   string             `mikrotik:"invalid-after,readonly" codegen:"invalid_after,computed"`
+	InvalidBefore  string             `mikrotik:"invalid-before,readonly" codegen:"invalid_before,computed"`
+	Issuer         string             `mikrotik:"issuer,readonly" codegen:"issuer,computed"`
+	KeySize        string             `mikrotik:"key-size" codegen:"key_size"`
+	KeyUsage       types.MikrotikList `mikrotik:"key-usage" codegen:"key_usage"`
+	Locality       string             `mikrotik:"locality" codegen:"locality"`
+	Organization   string             `mikrotik:"organization" codegen:"organization"`
+	PrivateKey     bool               `mikrotik:"private-key,readonly" codegen:"private_key,computed"`
+	SerialNumber   string             `mikrotik:"serial-number,readonly" codegen:"serial_number,computed"`
+	State          string             `mikrotik:"state" codegen:"state"`
+	SubjectAltName types.MikrotikList `mikrotik:"subject-alt-name" codegen:"subject_alt_name"`
+	Trusted        bool               `mikrotik:"trusted" codegen:"trusted"`
+	Unit           string             `mikrotik:"unit" codegen:"unit"`
+}
+
+var _ Resource = (*Certificate)(nil)
+
+func (b *Certificate) ActionToCommand(a Action) string {
+	return map[Action]string{
+		Add:    "/certificate/add",
+		Find:   "/certificate/print",
+		List:   "/certificate/print",
+		Update: "/certificate/set",
+		Delete: "/certificate/remove",
+	}[a]
+}
+
+func (b *Certificate) IDField() string {
+	return ".id"
+}
+
+func (b *Certificate) ID() string {
+	return b.Id
+}
+
+func (b *Certificate) SetID(id string) {
+	b.Id = id
+}
+
+func (b *Certificate) AfterAddHook(r *routeros.Reply) {
+	b.Id = r.Done.Map["ret"]
+}
+
+func (b *Certificate) FindField() string {
+	return "name"
+}
+
+func (b *Certificate) FindFieldValue() string {
+	return b.Name
+}
+
+func (b *Certificate) DeleteField() string {
+	return "numbers"
+}
+
+func (b *Certificate) DeleteFieldValue() string {
+	return b.Name
+}
+
+// Typed wrappers
+func (c Mikrotik) AddCertificate(r *Certificate) (*Certificate, error) {
+	res, err := c.Add(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*Certificate), nil
+}
+
+func (c Mikrotik) FindCertificate(name string) (*Certificate, error) {
+	res, err := c.Find(&Certificate{Name: name})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*Certificate), nil
+}
+
+func (c Mikrotik) ListCertificates() ([]Certificate, error) {
+	res, err := c.List(&Certificate{})
+	if err != nil {
+		return nil, err
+	}
+	returnSlice := make([]Certificate, len(res))
+	for i, v := range res {
+		returnSlice[i] = *(v.(*Certificate))
+	}
+
+	return returnSlice, nil
+}
+
+func (c Mikrotik) DeleteCertificate(name string) error {
+	return c.Delete(&Certificate{Name: name})
+}
+
+// SetCertificateTrusted changes trusted flag of the certificate.
+//
+// Other properties of a signed or imported certificate cannot be modified.
+func (c Mikrotik) SetCertificateTrusted(name string, trusted bool) (*Certificate, error) {
+	if err := c.runCertificateCommand("/certificate/set", "=numbers="+name, "=trusted="+boolToMikrotikBool(trusted)); err != nil {
+		return nil, err
+	}
+
+	return c.FindCertificate(name)
+}
+
+// SignCertificate signs the certificate template with the local CA certificate.
+//
+// If ca is empty, the certificate is self-signed.
+func (c Mikrotik) SignCertificate(name, ca string) (*Certificate, error) {
+	args := []string{"=numbers=" + name}
+	if ca != "" {
+		args = append(args, "=ca="+ca)
+	}
+	if err := c.runCertificateCommand("/certificate/sign", args...); err != nil {
+		return nil, err
+	}
+
+	return c.FindCertificate(name)
+}
+
+// ImportCertificate imports PEM encoded certificate and, optionally, its private key under the given name.
+//
+// The PEM data is uploaded to temporary files, which are removed once the import is done.
+// Uploading files with contents via /file/add is supported by RouterOS v7 only.
+// The imported certificate is identified by its fingerprint, so only a single certificate can be imported at once.
+// If naming the certificate or importing its private key fails, the imported certificate is removed again.
+func (c Mikrotik) ImportCertificate(name, certificatePem, privateKeyPem, passphrase string) (*Certificate, error) {
+	fingerprint, err := certificateFingerprint(certificatePem)
+	if err != nil {
+		return nil, err
+	}
+	existing, err := c.findCertificateByFingerprint(fingerprint)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, fmt.Errorf("certificate is already imported as %q", existing.Name)
+	}
+
+	if err := c.importPemFile(name+".crt", certificatePem, passphrase); err != nil {
+		return nil, err
+	}
+
+	imported, err := c.findCertificateByFingerprint(fingerprint)
+	if err != nil {
+		return nil, err
+	}
+	if imported == nil {
+		return nil, errors.New("no certificate was imported")
+	}
+
+	cert, err := c.finishCertificateImport(imported.Id, name, privateKeyPem, passphrase)
+	if err != nil {
+		// do not leave a half imported certificate behind, it would block importing the same certificate again
+		if removeErr := c.runCertificateCommand("/certificate/remove", "=.id="+imported.Id); removeErr != nil {
+			log.Printf("[WARN] Could not remove imported certificate %q: %v", imported.Id, removeErr)
+		}
+		return nil, err
+	}
+
+	return cert, nil
+}
+
+// finishCertificateImport names the imported certificate and imports its private key.
+func (c Mikrotik) finishCertificateImport(id, name, privateKeyPem, passphrase string) (*Certificate, error) {
+	if err := c.runCertificateCommand("/certificate/set", "=.id="+id, "=name="+name); err != nil {
+		return nil, err
+	}
+
+	if privateKeyPem != "" {
+		if err := c.importPemFile(name+".key", privateKeyPem, passphrase); err != nil {
+			return nil, err
+		}
+	}
+
+	cert, err := c.FindCertificate(name)
+	if err != nil {
+		return nil, err
+	}
+	if privateKeyPem != "" && !cert.PrivateKey {
+		return nil, fmt.Errorf("private key does not match certificate %q", name)
+	}
+
+	return cert, nil
+}
+
+func (c Mikrotik) findCertificateByFingerprint(fingerprint string) (*Certificate, error) {
+	certificates, err := c.ListCertificates()
+	if err != nil {
+		return nil, err
+	}
+	for i := range certificates {
+		if strings.EqualFold(strings.ReplaceAll(certificates[i].Fingerprint, ":", ""), fingerprint) {
+			return &certificates[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+// certificateFingerprint returns SHA-256 fingerprint of the single certificate in PEM encoded data, as RouterOS reports it.
+func certificateFingerprint(certificatePem string) (string, error) {
+	var der []byte
+	rest := []byte(certificatePem)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		if der != nil {
+			return "", errors.New("PEM data contains more than one certificate, import each certificate separately")
+		}
+		der = block.Bytes
+	}
+	if der == nil {
+		return "", errors.New("PEM data contains no certificate")
+	}
+	sum := sha256.Sum256(der)
+
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func (c Mikrotik) importPemFile(fileName, contents, passphrase string) error {
+	client, err := c.getMikrotikClient()
+	if err != nil {
+		return err
+	}
+
+	// do not log the PEM data, it may contain a private key
+	log.Printf("[INFO] Running the mikrotik command: `/file/add =name=%s`", fileName)
+	if _, err := client.RunArgs([]string{"/file/add", "=name=" + fileName, "=contents=" + contents}); err != nil {
+		return err
+	}
+	defer func() {
+		if err := c.runCertificateCommand("/file/remove", "=numbers="+fileName); err != nil {
+			log.Printf("[WARN] Could not remove temporary file %q: %v", fileName, err)
+		}
+	}()
+
+	// do not log the passphrase
+	log.Printf("[INFO] Running the mikrotik command: `/certificate/import =file-name=%s`", fileName)
+	_, err = client.RunArgs([]string{"/certificate/import", "=file-name=" + fileName, "=passphrase=" + passphrase})
+
+	return err
+}
+
+func (c Mikrotik) runCertificateCommand(command string, args ...string) error {
+	client, err := c.getMikrotikClient()
+	if err != nil {
+		return err
+	}
+
+	cmd := append([]string{command}, args...)
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	_, err = client.RunArgs(cmd)
+
+	return err
+}
@@ -0,0 +1,143 @@
+package client
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCertificate_signWithLocalCA(t *testing.T) {
+	c := NewClient(GetConfigFromEnv())
+
+	ca, err := c.AddCertificate(&Certificate{
+		Name:       "test-ca-" + RandomString(),
+		CommonName: "Test CA",
+		DaysValid:  30,
+		KeySize:    "2048",
+		KeyUsage:   types.MikrotikList{"key-cert-sign", "crl-sign"},
+	})
+	require.NoError(t, err)
+	defer func(name string) {
+		assert.NoError(t, c.DeleteCertificate(name))
+	}(ca.Name)
+
+	ca, err = c.SignCertificate(ca.Name, "")
+	require.NoError(t, err)
+	assert.True(t, ca.PrivateKey)
+	assert.NotEmpty(t, ca.Fingerprint)
+
+	server, err := c.AddCertificate(&Certificate{
+		Name:           "test-server-" + RandomString(),
+		CommonName:     "router.example.com",
+		DaysValid:      30,
+		KeySize:        "2048",
+		KeyUsage:       types.MikrotikList{"digital-signature", "key-encipherment", "tls-server"},
+		SubjectAltName: types.MikrotikList{"DNS:router.example.com"},
+	})
+	require.NoError(t, err)
+	defer func(name string) {
+		assert.NoError(t, c.DeleteCertificate(name))
+	}(server.Name)
+
+	server, err = c.SignCertificate(server.Name, ca.Name)
+	require.NoError(t, err)
+	assert.Equal(t, "router.example.com", server.CommonName)
+	assert.Contains(t, server.Issuer, "Test CA")
+
+	server, err = c.SetCertificateTrusted(server.Name, true)
+	require.NoError(t, err)
+	assert.True(t, server.Trusted)
+}
+
+func TestCertificate_import(t *testing.T) {
+	SkipIfRouterOSV6OrEarlier(t, sysResources)
+	c := NewClient(GetConfigFromEnv())
+
+	certificatePem, privateKeyPem := generateTestCertificate(t, "imported.example.com")
+	name := "test-imported-" + RandomString()
+
+	imported, err := c.ImportCertificate(name, certificatePem, privateKeyPem, "")
+	require.NoError(t, err)
+	defer func(name string) {
+		assert.NoError(t, c.DeleteCertificate(name))
+	}(imported.Name)
+
+	assert.Equal(t, name, imported.Name)
+	assert.Equal(t, "imported.example.com", imported.CommonName)
+	assert.True(t, imported.PrivateKey)
+
+	_, err = c.ImportCertificate(name+"-again", certificatePem, "", "")
+	assert.EqualError(t, err, "certificate is already imported as \""+name+"\"")
+}
+
+func TestCertificate_importRemovedOnKeyMismatch(t *testing.T) {
+	SkipIfRouterOSV6OrEarlier(t, sysResources)
+	c := NewClient(GetConfigFromEnv())
+
+	certificatePem, _ := generateTestCertificate(t, "mismatch.example.com")
+	_, otherKeyPem := generateTestCertificate(t, "other.example.com")
+	name := "test-mismatch-" + RandomString()
+
+	_, err := c.ImportCertificate(name, certificatePem, otherKeyPem, "")
+	require.Error(t, err)
+
+	_, err = c.FindCertificate(name)
+	assert.True(t, IsNotFoundError(err), "expected the imported certificate to be removed, got %v", err)
+
+	fingerprint, err := certificateFingerprint(certificatePem)
+	require.NoError(t, err)
+	leftover, err := c.findCertificateByFingerprint(fingerprint)
+	require.NoError(t, err)
+	assert.Nil(t, leftover)
+}
+
+func TestCertificateFingerprint(t *testing.T) {
+	certificatePem, privateKeyPem := generateTestCertificate(t, "fingerprint.example.com")
+	block, _ := pem.Decode([]byte(certificatePem))
+	sum := sha256.Sum256(block.Bytes)
+
+	fingerprint, err := certificateFingerprint(privateKeyPem + certificatePem)
+	require.NoError(t, err)
+	assert.Equal(t, hex.EncodeToString(sum[:]), fingerprint)
+
+	chainPem, _ := generateTestCertificate(t, "ca.example.com")
+	_, err = certificateFingerprint(certificatePem + chainPem)
+	assert.Error(t, err)
+
+	_, err = certificateFingerprint(privateKeyPem)
+	assert.Error(t, err)
+}
+
+// generateTestCertificate creates self-signed certificate and returns it along with its private key in PEM format.
+func generateTestCertificate(t *testing.T, commonName string) (string, string) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		DNSNames:     []string{commonName},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	certificatePem := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	privateKeyPem := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+
+	return string(certificatePem), string(privateKeyPem)
+}
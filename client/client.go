@@ -39,6 +39,12 @@ type (
 		// UnmarshalMikrotik de-serializes RouterOS field into Go type value
 		UnmarshalMikrotik(string) error
 	}
+
+	// PropertyOmitter defines contract for types, which send some properties only in particular configurations
+	PropertyOmitter interface {
+		// OmitMikrotikProperty reports whether the property must not be sent to RouterOS
+		OmitMikrotikProperty(name string) bool
+	}
 )
 
 // NewClient initializes new Mikrotik client object
@@ -54,6 +60,15 @@ func NewClient(host, username, password string, tls bool, caCertificate string,
 }
 
 func Marshal(c string, s interface{}) []string {
+	return marshal(c, s, nil)
+}
+
+// MarshalClearing works like Marshal, but sends zero values of the given properties too, so they can be cleared.
+func MarshalClearing(c string, s interface{}, clearable map[string]bool) []string {
+	return marshal(c, s, clearable)
+}
+
+func marshal(c string, s interface{}, clearable map[string]bool) []string {
 	var elem reflect.Value
 	rv := reflect.ValueOf(s)
 
@@ -65,6 +80,8 @@ func Marshal(c string, s interface{}) []string {
 	}
 
 	cmd := []string{c}
+	negated := negatedProperties(elem)
+	omitter, _ := s.(PropertyOmitter)
 
 	for i := 0; i < elem.NumField(); i++ {
 		value := elem.Field(i)
@@ -79,7 +96,25 @@ func Marshal(c string, s interface{}) []string {
 		// so leave only modifiers in this slice
 		mikrotikTags = mikrotikTags[1:]
 
-		if mikrotikPropName != "" && (!value.IsZero() || value.Kind() == reflect.Bool) {
+		if contains(mikrotikTags, "negate") {
+			// negation flags are sent as part of the property they negate
+			continue
+		}
+		if omitter != nil && omitter.OmitMikrotikProperty(mikrotikPropName) {
+			continue
+		}
+		if value.Kind() == reflect.Ptr {
+			// unset optional values are not sent, so RouterOS keeps the default or inherited ones
+			if value.IsNil() {
+				continue
+			}
+			value = value.Elem()
+		}
+
+		// empty values of 'clearable' properties are always sent, as they cannot be cleared otherwise
+		sendEmpty := clearable[mikrotikPropName] || contains(mikrotikTags, "clearable")
+
+		if mikrotikPropName != "" && (!value.IsZero() || value.Kind() == reflect.Bool || sendEmpty) {
 			// add conditional to check if a Mikrotik property is READ ONLY, such as the following wireguard props
 			// https://help.mikrotik.com/docs/display/ROS/WireGuard#WireGuard-Read-onlyproperties
 			if contains(mikrotikTags, "readonly") {
@@ -87,27 +122,32 @@ func Marshal(c string, s interface{}) []string {
 				// if a struct field contains the tag value of 'readonly', do not marshal it
 				continue
 			}
+			if value.Kind() == reflect.Bool && value.IsZero() && contains(mikrotikTags, "omitempty") && !sendEmpty {
+				// booleans are always sent, unless they are 'omitempty' flags supported only by some RouterOS versions
+				continue
+			}
 
+			var stringValue string
 			if mar, ok := value.Interface().(Marshaler); ok {
 				// if type supports custom marshaling, use that result immediately
-				stringValue := mar.MarshalMikrotik()
-				cmd = append(cmd, fmt.Sprintf("=%s=%s", mikrotikPropName, stringValue))
-				continue
+				stringValue = mar.MarshalMikrotik()
+			} else {
+				switch value.Kind() {
+				case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+					reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+					stringValue = fmt.Sprintf("%d", value.Interface())
+				case reflect.String:
+					stringValue = value.Interface().(string)
+				case reflect.Bool:
+					stringValue = boolToMikrotikBool(value.Interface().(bool))
+				default:
+					continue
+				}
 			}
-
-			switch value.Kind() {
-			case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-				cmd = append(cmd, fmt.Sprintf("=%s=%d", mikrotikPropName, elem.Field(i).Interface()))
-			case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
-				cmd = append(cmd, fmt.Sprintf("=%s=%d", mikrotikPropName, elem.Field(i).Interface()))
-			case reflect.String:
-				stringValue := elem.Field(i).Interface().(string)
-				cmd = append(cmd, fmt.Sprintf("=%s=%s", mikrotikPropName, stringValue))
-			case reflect.Bool:
-				boolValue := elem.Field(i).Interface().(bool)
-				stringBoolValue := boolToMikrotikBool(boolValue)
-				cmd = append(cmd, fmt.Sprintf("=%s=%s", mikrotikPropName, stringBoolValue))
+			if negated[mikrotikPropName] && stringValue != "" {
+				stringValue = "!" + stringValue
 			}
+			cmd = append(cmd, fmt.Sprintf("=%s=%s", mikrotikPropName, stringValue))
 		}
 	}
 
@@ -228,6 +268,7 @@ func (client *Mikrotik) getMikrotikClient() (*routeros.Client, error) {
 
 func parseStruct(v *reflect.Value, sentence proto.Sentence) {
 	elem := *v
+	negatable := negatableProperties(elem)
 	for i := 0; i < elem.NumField(); i++ {
 		field := elem.Field(i)
 		fieldType := elem.Type().Field(i)
@@ -238,6 +279,13 @@ func parseStruct(v *reflect.Value, sentence proto.Sentence) {
 
 		for _, pair := range sentence.List {
 			if strings.Compare(pair.Key, path) == 0 || strings.Compare(pair.Key, fieldName) == 0 {
+				if contains(tags[1:], "negate") {
+					field.SetBool(strings.HasPrefix(pair.Value, "!"))
+					continue
+				}
+				if negatable[fieldName] {
+					pair.Value = strings.TrimPrefix(pair.Value, "!")
+				}
 				if field.CanAddr() {
 					if unmar, ok := field.Addr().Interface().(Unmarshaler); ok {
 						// if type supports custom unmarshaling, try it and skip the rest
@@ -254,6 +302,11 @@ func parseStruct(v *reflect.Value, sentence proto.Sentence) {
 				case reflect.Bool:
 					b, _ := strconv.ParseBool(pair.Value)
 					field.SetBool(b)
+				case reflect.Ptr:
+					if fieldType.Type.Elem().Kind() == reflect.Bool {
+						b, _ := strconv.ParseBool(pair.Value)
+						field.Set(reflect.ValueOf(&b))
+					}
 				case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
 					intValue, _ := strconv.Atoi(pair.Value)
 					field.SetInt(int64(intValue))
@@ -266,6 +319,35 @@ func parseStruct(v *reflect.Value, sentence proto.Sentence) {
 	}
 }
 
+// negatableProperties returns names of properties which have a negation flag in the struct.
+//
+// A negation flag is a bool field tagged with the name of the property it negates and the 'negate' modifier,
+// RouterOS represents it as `!` in front of the property value.
+func negatableProperties(elem reflect.Value) map[string]bool {
+	result := map[string]bool{}
+	for i := 0; i < elem.NumField(); i++ {
+		tags := strings.Split(elem.Type().Field(i).Tag.Get("mikrotik"), ",")
+		if contains(tags[1:], "negate") {
+			result[tags[0]] = true
+		}
+	}
+
+	return result
+}
+
+// negatedProperties returns names of properties which negation flag is set.
+func negatedProperties(elem reflect.Value) map[string]bool {
+	result := map[string]bool{}
+	for i := 0; i < elem.NumField(); i++ {
+		tags := strings.Split(elem.Type().Field(i).Tag.Get("mikrotik"), ",")
+		if contains(tags[1:], "negate") && elem.Field(i).Bool() {
+			result[tags[0]] = true
+		}
+	}
+
+	return result
+}
+
 func contains(s []string, e string) bool {
 	for _, a := range s {
 		if a == e {
@@ -4,16 +4,23 @@ import (
 	"fmt"
 	"log"
 	"reflect"
+	"sort"
+	"strings"
+	"sync"
 
 	"github.com/go-routeros/routeros"
 )
 
+// batchSize limits number of commands in flight or resources per command during batch operations.
+const batchSize = 100
+
 const (
 	Add    Action = "add"
 	Update Action = "update"
 	Find   Action = "find"
 	List   Action = "list"
 	Delete Action = "delete"
+	Move   Action = "move"
 )
 
 type (
@@ -78,6 +85,19 @@ type (
 	ResourceInstanceCreator interface {
 		Create() Resource
 	}
+
+	// Singleton interface defines a contract for RouterOS menus which hold exactly one object, e.g. `/ip/dns`.
+	// Such menus cannot be added or removed, so only Find and Update actions are supported.
+	Singleton interface {
+		// ActionToCommand translates Find and Update actions to RouterOS command path.
+		ActionToCommand(Action) string
+	}
+
+	// Resetter defines contract for singletons which restore default settings when removed from management.
+	Resetter interface {
+		// DefaultValues returns RouterOS property names and their default values.
+		DefaultValues() map[string]string
+	}
 )
 
 // Add creates new resource on remote system
@@ -104,9 +124,161 @@ func (client Mikrotik) Add(d Resource) (Resource, error) {
 	return client.Find(d)
 }
 
-// Find retrieves resource from remote system
+// List retrieves all resources of the same type from remote system
 func (client Mikrotik) List(d Resource) ([]Resource, error) {
-	cmd := []string{d.ActionToCommand(Find)}
+	return client.list(d)
+}
+
+// ListByField retrieves resources of the same type, which field matches the value, from remote system
+func (client Mikrotik) ListByField(d Resource, field, value string) ([]Resource, error) {
+	return client.list(d, "?"+field+"="+value)
+}
+
+// ListIDs retrieves IDs of the resources with given IDs in the order they are located on remote system.
+//
+// Only the IDs are requested, so it is cheap to use for checking positions of items in ordered lists.
+// IDs of resources which do not exist are omitted.
+func (client Mikrotik) ListIDs(d Resource, ids ...string) ([]string, error) {
+	if len(ids) == 0 {
+		return []string{}, nil
+	}
+
+	cmd := []string{d.ActionToCommand(Find), "=.proplist=" + d.IDField()}
+	for _, id := range ids {
+		cmd = append(cmd, "?"+d.IDField()+"="+id)
+	}
+	if len(ids) > 1 {
+		// combine all the conditions with OR operation
+		cmd = append(cmd, "?#"+strings.Repeat("|", len(ids)-1))
+	}
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+
+	c, err := client.getMikrotikClient()
+	if err != nil {
+		return nil, err
+	}
+	r, err := c.RunArgs(cmd)
+	if eh, ok := d.(ErrorHandler); ok {
+		err = eh.HandleError(err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[DEBUG] list response: %v", r)
+
+	result := make([]string, 0, len(r.Re))
+	for _, sentence := range r.Re {
+		result = append(result, sentence.Map[d.IDField()])
+	}
+
+	return result, nil
+}
+
+// AddMany creates multiple resources on remote system.
+//
+// The commands are pipelined over a dedicated connection, so the operation does not wait for a round trip per resource.
+// Unlike Add, the created resources are not read back.
+// All the items are attempted, even if some of them fail, and the returned error joins errors of all failed items.
+func (client Mikrotik) AddMany(items []Resource) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	// use separate connection, as asynchronous mode cannot be turned off
+	batchClient := client
+	batchClient.connection = nil
+	c, err := batchClient.getMikrotikClient()
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+	asyncErrs := c.Async()
+
+	errs := make(chan error, len(items))
+	inFlight := make(chan struct{}, batchSize)
+	var wg sync.WaitGroup
+	for _, item := range items {
+		wg.Add(1)
+		inFlight <- struct{}{}
+		go func(d Resource) {
+			defer func() {
+				<-inFlight
+				wg.Done()
+			}()
+
+			cmd := Marshal(d.ActionToCommand(Add), d)
+			log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+			_, err := c.RunArgs(cmd)
+			if eh, ok := d.(ErrorHandler); ok {
+				err = eh.HandleError(err)
+			}
+			if err != nil {
+				errs <- err
+			}
+		}(item)
+	}
+	wg.Wait()
+	close(errs)
+
+	var result []error
+	select {
+	case err := <-asyncErrs:
+		// the connection failed, so the errors of the particular commands are likely caused by it
+		if err != nil {
+			result = append(result, err)
+		}
+	default:
+	}
+	for err := range errs {
+		result = append(result, err)
+	}
+
+	return joinErrors(result...)
+}
+
+// DeleteMany removes multiple resources of the same type from remote system.
+//
+// The resources are removed by their IDs, passing a chunk of IDs per command.
+func (client Mikrotik) DeleteMany(d Resource, ids []string) error {
+	return client.runMany(d, d.ActionToCommand(Delete), ids)
+}
+
+// SetMany sets the same properties on multiple resources of the same type on remote system.
+//
+// The properties are passed as API words, e.g. "=comment=text", and the resources are changed by their IDs,
+// passing a chunk of IDs per command.
+func (client Mikrotik) SetMany(d Resource, ids []string, properties ...string) error {
+	return client.runMany(d, d.ActionToCommand(Update), ids, properties...)
+}
+
+// runMany runs the command for chunks of IDs, so the number of round trips stays small for many resources.
+func (client Mikrotik) runMany(d Resource, command string, ids []string, args ...string) error {
+	c, err := client.getMikrotikClient()
+	if err != nil {
+		return err
+	}
+
+	for start := 0; start < len(ids); start += batchSize {
+		end := start + batchSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+		cmd := append([]string{command, "=numbers=" + strings.Join(ids[start:end], ",")}, args...)
+		log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+		_, err = c.RunArgs(cmd)
+		if eh, ok := d.(ErrorHandler); ok {
+			err = eh.HandleError(err)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (client Mikrotik) list(d Resource, query ...string) ([]Resource, error) {
+	cmd := append([]string{d.ActionToCommand(Find)}, query...)
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
 
 	c, err := client.getMikrotikClient()
@@ -197,6 +369,164 @@ func (client Mikrotik) Delete(d Resource) error {
 	return err
 }
 
+// FindSingleton retrieves settings of a singleton menu from remote system
+func (client Mikrotik) FindSingleton(s Singleton) (Singleton, error) {
+	cmd := []string{s.ActionToCommand(Find)}
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+
+	c, err := client.getMikrotikClient()
+	if err != nil {
+		return nil, err
+	}
+	r, err := c.RunArgs(cmd)
+	if eh, ok := s.(ErrorHandler); ok {
+		err = eh.HandleError(err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[DEBUG] find response: %v", r)
+
+	targetStruct := client.newTargetStruct(s)
+	targetStructInterface := targetStruct.Interface()
+	if err := Unmarshal(*r, targetStructInterface); err != nil {
+		return nil, err
+	}
+
+	if n, ok := targetStructInterface.(Normalizer); ok {
+		n.Normalize(r)
+	}
+
+	return targetStructInterface.(Singleton), nil
+}
+
+// UpdateSingleton changes settings of a singleton menu on remote system
+//
+// Unlike Update, it sends empty values of the settings as well, since singletons are always managed as a whole.
+func (client Mikrotik) UpdateSingleton(s Singleton) (Singleton, error) {
+	c, err := client.getMikrotikClient()
+	if err != nil {
+		return nil, err
+	}
+
+	// empty values must be sent explicitly to clear the settings,
+	// but only properties reported by the router are cleared, as some of them exist in certain RouterOS versions only
+	reported, err := client.singletonProperties(s)
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := MarshalClearing(s.ActionToCommand(Update), s, reported)
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	_, err = c.RunArgs(cmd)
+	if eh, ok := s.(ErrorHandler); ok {
+		err = eh.HandleError(err)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return client.FindSingleton(s)
+}
+
+// ResetSingleton restores default settings of a singleton menu on remote system.
+//
+// It is a no-op for singletons which do not implement Resetter interface.
+func (client Mikrotik) ResetSingleton(s Singleton) error {
+	r, ok := s.(Resetter)
+	if !ok {
+		return nil
+	}
+
+	c, err := client.getMikrotikClient()
+	if err != nil {
+		return err
+	}
+
+	// defaults may cover properties of several RouterOS versions, so only the reported ones are restored
+	reported, err := client.singletonProperties(s)
+	if err != nil {
+		return err
+	}
+
+	defaults := r.DefaultValues()
+	keys := make([]string, 0, len(defaults))
+	for k := range defaults {
+		if reported[k] {
+			keys = append(keys, k)
+		}
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	sort.Strings(keys)
+
+	cmd := []string{s.ActionToCommand(Update)}
+	for _, k := range keys {
+		cmd = append(cmd, "="+k+"="+defaults[k])
+	}
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	_, err = c.RunArgs(cmd)
+	if eh, ok := s.(ErrorHandler); ok {
+		err = eh.HandleError(err)
+	}
+
+	return err
+}
+
+// singletonProperties returns names of the properties which the router reports for the singleton menu.
+func (client Mikrotik) singletonProperties(s Singleton) (map[string]bool, error) {
+	c, err := client.getMikrotikClient()
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := []string{s.ActionToCommand(Find)}
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	r, err := c.RunArgs(cmd)
+	if eh, ok := s.(ErrorHandler); ok {
+		err = eh.HandleError(err)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	reported := map[string]bool{}
+	for _, sentence := range r.Re {
+		for _, pair := range sentence.List {
+			reported[pair.Key] = true
+		}
+	}
+
+	return reported, nil
+}
+
+// Move changes position of existing resource in ordered list on remote system, placing it before destination resource.
+// If destination is empty, the resource is moved to the end of the list.
+func (client Mikrotik) Move(d Resource, destinationID string) error {
+	c, err := client.getMikrotikClient()
+	if err != nil {
+		return err
+	}
+
+	cmd := []string{d.ActionToCommand(Move), "=numbers=" + d.ID()}
+	if destinationID != "" {
+		cmd = append(cmd, "=destination="+destinationID)
+	}
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	_, err = c.RunArgs(cmd)
+	if rosErr, ok := err.(*routeros.DeviceError); ok {
+		if rosErr.Sentence.Map["message"] == "no such item" {
+			return NewNotFound(rosErr.Sentence.Map["message"])
+		}
+	}
+	if eh, ok := d.(ErrorHandler); ok {
+		err = eh.HandleError(err)
+	}
+
+	return err
+}
+
 func (client Mikrotik) findByField(d Resource, field, value string) (Resource, error) {
 	cmd := []string{d.ActionToCommand(Find), "?" + field + "=" + value}
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
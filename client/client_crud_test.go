@@ -0,0 +1,51 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testSnmpSettings struct {
+	Contact  string `mikrotik:"contact"`
+	Location string `mikrotik:"location"`
+}
+
+func (s *testSnmpSettings) ActionToCommand(a Action) string {
+	return map[Action]string{
+		Find:   "/snmp/print",
+		Update: "/snmp/set",
+	}[a]
+}
+
+func (s *testSnmpSettings) DefaultValues() map[string]string {
+	return map[string]string{
+		"contact":  "",
+		"location": "",
+	}
+}
+
+func TestSingleton_updateAndReset(t *testing.T) {
+	c := NewClient(GetConfigFromEnv())
+
+	expected := &testSnmpSettings{
+		Contact:  "noc@example.com",
+		Location: "rack " + RandomString(),
+	}
+	defer func() {
+		require.NoError(t, c.ResetSingleton(&testSnmpSettings{}))
+
+		found, err := c.FindSingleton(&testSnmpSettings{})
+		require.NoError(t, err)
+		assert.Equal(t, &testSnmpSettings{}, found)
+	}()
+
+	updated, err := c.UpdateSingleton(expected)
+	require.NoError(t, err)
+	assert.Equal(t, expected, updated)
+
+	found, err := c.FindSingleton(&testSnmpSettings{})
+	require.NoError(t, err)
+	assert.Equal(t, expected, found)
+}
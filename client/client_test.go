@@ -8,6 +8,7 @@ import (
 	"github.com/go-routeros/routeros"
 	"github.com/go-routeros/routeros/proto"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestUnmarshal(t *testing.T) {
@@ -300,6 +301,8 @@ func TestMarshal(t *testing.T) {
 				CountUint64   uint64 `mikrotik:"run-count-uint64"`
 				ReadOnlyProp  bool   `mikrotik:"read-only-prop,readonly"`
 				Allowed       bool   `mikrotik:"allowed-or-not"`
+				OmittedFlag   bool   `mikrotik:"omitted-flag,omitempty"`
+				SentFlag      bool   `mikrotik:"sent-flag,omitempty"`
 			}{
 				Name:          "test owner",
 				NotNamedOwner: "admin",
@@ -314,6 +317,7 @@ func TestMarshal(t *testing.T) {
 				CountUint32:   15_000_000,
 				CountUint64:   15_000_000_000_000_000,
 				Allowed:       true,
+				SentFlag:      true,
 			},
 			expectedCmd: []string{
 				"/test/owner/add",
@@ -330,6 +334,7 @@ func TestMarshal(t *testing.T) {
 				"=run-count-uint32=15000000",
 				"=run-count-uint64=15000000000000000",
 				"=allowed-or-not=yes",
+				"=sent-flag=yes",
 			},
 		},
 		{
@@ -356,6 +361,39 @@ func TestMarshal(t *testing.T) {
 				"=schedule=mon,tue,fri",
 			},
 		},
+		{
+			name: "clearable properties",
+			testStruct: struct {
+				Name    string             `mikrotik:"name"`
+				Address types.MikrotikList `mikrotik:"address,clearable"`
+				Comment string             `mikrotik:"comment"`
+			}{
+				Name: "api",
+			},
+			expectedCmd: []string{
+				"/test/owner/add",
+				"=name=api",
+				"=address=",
+			},
+		},
+		{
+			name: "negated properties",
+			testStruct: struct {
+				State          types.MikrotikList `mikrotik:"state"`
+				StateNegate    bool               `mikrotik:"state,negate"`
+				NatState       types.MikrotikList `mikrotik:"nat-state"`
+				NatStateNegate bool               `mikrotik:"nat-state,negate"`
+			}{
+				State:       []string{"established", "related"},
+				StateNegate: true,
+				NatState:    []string{"dstnat"},
+			},
+			expectedCmd: []string{
+				"/test/owner/add",
+				"=state=!established,related",
+				"=nat-state=dstnat",
+			},
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -367,6 +405,118 @@ func TestMarshal(t *testing.T) {
 	}
 }
 
+func TestUnmarshalNegatedProperties(t *testing.T) {
+	type testStruct struct {
+		State          types.MikrotikList `mikrotik:"state"`
+		StateNegate    bool               `mikrotik:"state,negate"`
+		NatState       types.MikrotikList `mikrotik:"nat-state"`
+		NatStateNegate bool               `mikrotik:"nat-state,negate"`
+	}
+	reply := routeros.Reply{
+		Re: []*proto.Sentence{
+			{
+				Word: "!re",
+				List: []proto.Pair{
+					{Key: "state", Value: "!established,related"},
+					{Key: "nat-state", Value: "dstnat"},
+				},
+			},
+		},
+	}
+
+	var result testStruct
+	require.NoError(t, Unmarshal(reply, &result))
+	assert.Equal(t, testStruct{
+		State:       types.MikrotikList{"established", "related"},
+		StateNegate: true,
+		NatState:    types.MikrotikList{"dstnat"},
+	}, result)
+}
+
+func TestMarshalClearing(t *testing.T) {
+	testStruct := struct {
+		Servers      types.MikrotikList     `mikrotik:"servers"`
+		DohServer    string                 `mikrotik:"use-doh-server"`
+		CacheSize    int                    `mikrotik:"cache-size"`
+		Interim      types.MikrotikDuration `mikrotik:"interim-update"`
+		PrimaryNtp   string                 `mikrotik:"primary-ntp"`
+		Status       string                 `mikrotik:"status,readonly"`
+		OptionalFlag bool                   `mikrotik:"optional-flag,omitempty"`
+	}{
+		CacheSize: 2048,
+	}
+	clearable := map[string]bool{
+		"servers":        true,
+		"use-doh-server": true,
+		"cache-size":     true,
+		"interim-update": true,
+		"status":         true,
+		"optional-flag":  true,
+	}
+
+	cmd := MarshalClearing("/ip/dns/set", &testStruct, clearable)
+	assert.Equal(t, []string{
+		"/ip/dns/set",
+		"=servers=",
+		"=use-doh-server=",
+		"=cache-size=2048",
+		"=interim-update=0",
+		"=optional-flag=no",
+	}, cmd)
+}
+
+type testPropertyOmitter struct {
+	Action      string `mikrotik:"action"`
+	Passthrough bool   `mikrotik:"passthrough"`
+}
+
+func (o *testPropertyOmitter) OmitMikrotikProperty(name string) bool {
+	return name == "passthrough" && o.Action == "accept"
+}
+
+func TestMarshalPropertyOmitter(t *testing.T) {
+	cmd := Marshal("/ip/firewall/mangle/add", &testPropertyOmitter{Action: "accept", Passthrough: true})
+	assert.Equal(t, []string{"/ip/firewall/mangle/add", "=action=accept"}, cmd)
+
+	cmd = Marshal("/ip/firewall/mangle/add", &testPropertyOmitter{Action: "mark-routing"})
+	assert.Equal(t, []string{"/ip/firewall/mangle/add", "=action=mark-routing", "=passthrough=no"}, cmd)
+}
+
+func TestMarshalOptionalBool(t *testing.T) {
+	type testStruct struct {
+		Name    string `mikrotik:"name"`
+		Connect *bool  `mikrotik:"connect"`
+		Listen  *bool  `mikrotik:"listen"`
+	}
+	connect := false
+
+	cmd := Marshal("/routing/bgp/connection/add", &testStruct{Name: "peer", Connect: &connect})
+	assert.Equal(t, []string{"/routing/bgp/connection/add", "=name=peer", "=connect=no"}, cmd)
+}
+
+func TestUnmarshalOptionalBool(t *testing.T) {
+	type testStruct struct {
+		Connect *bool `mikrotik:"connect"`
+		Listen  *bool `mikrotik:"listen"`
+	}
+	reply := routeros.Reply{
+		Re: []*proto.Sentence{
+			{
+				Word: "!re",
+				List: []proto.Pair{
+					{Key: "connect", Value: "false"},
+				},
+			},
+		},
+	}
+
+	var result testStruct
+	require.NoError(t, Unmarshal(reply, &result))
+	require.NotNil(t, result.Connect)
+	assert.False(t, *result.Connect)
+	assert.Nil(t, result.Listen)
+}
+
 func TestMarshalStructWithoutTags(t *testing.T) {
 	action := "/test/owner/add"
 	name := "test owner"
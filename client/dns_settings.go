@@ -0,0 +1,64 @@
+package client
+
+import (
+	"github.com/ddelnano/terraform-provider-mikrotik/client/types"
+)
+
+// DnsSettings defines /ip/dns settings
+type DnsSettings struct {
+	Servers                  types.MikrotikList     `mikrotik:"servers"`
+	AllowRemoteRequests      bool                   `mikrotik:"allow-remote-requests"`
+	CacheSize                int                    `mikrotik:"cache-size"`
+	CacheMaxTtl              types.MikrotikDuration `mikrotik:"cache-max-ttl"`
+	MaxConcurrentQueries     int                    `mikrotik:"max-concurrent-queries"`
+	MaxConcurrentTcpSessions int                    `mikrotik:"max-concurrent-tcp-sessions"`
+	UseDohServer             string                 `mikrotik:"use-doh-server"`
+	VerifyDohCert            bool                   `mikrotik:"verify-doh-cert"`
+}
+
+var (
+	_ Singleton = (*DnsSettings)(nil)
+	_ Resetter  = (*DnsSettings)(nil)
+)
+
+func (d *DnsSettings) ActionToCommand(action Action) string {
+	return map[Action]string{
+		Find:   "/ip/dns/print",
+		Update: "/ip/dns/set",
+	}[action]
+}
+
+func (d *DnsSettings) DefaultValues() map[string]string {
+	return map[string]string{
+		"servers":                     "",
+		"allow-remote-requests":       "no",
+		"cache-size":                  "2048",
+		"cache-max-ttl":               "1w",
+		"max-concurrent-queries":      "100",
+		"max-concurrent-tcp-sessions": "20",
+		"use-doh-server":              "",
+		"verify-doh-cert":             "no",
+	}
+}
+
+func (client Mikrotik) FindDnsSettings() (*DnsSettings, error) {
+	res, err := client.FindSingleton(&DnsSettings{})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*DnsSettings), nil
+}
+
+func (client Mikrotik) UpdateDnsSettings(d *DnsSettings) (*DnsSettings, error) {
+	res, err := client.UpdateSingleton(d)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*DnsSettings), nil
+}
+
+func (client Mikrotik) ResetDnsSettings() error {
+	return client.ResetSingleton(&DnsSettings{})
+}
@@ -0,0 +1,40 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDnsSettings_updateAndReset(t *testing.T) {
+	c := NewClient(GetConfigFromEnv())
+
+	expected := &DnsSettings{
+		Servers:                  types.MikrotikList{"1.1.1.1", "8.8.8.8"},
+		AllowRemoteRequests:      true,
+		CacheSize:                4096,
+		CacheMaxTtl:              types.MikrotikDuration(86400),
+		MaxConcurrentQueries:     150,
+		MaxConcurrentTcpSessions: 30,
+	}
+	defer func() {
+		require.NoError(t, c.ResetDnsSettings())
+
+		found, err := c.FindDnsSettings()
+		require.NoError(t, err)
+		assert.Empty(t, found.Servers)
+		assert.False(t, found.AllowRemoteRequests)
+		assert.Equal(t, 2048, found.CacheSize)
+	}()
+
+	updated, err := c.UpdateDnsSettings(expected)
+	require.NoError(t, err)
+	assert.Equal(t, expected, updated)
+
+	expected.Servers = nil
+	updated, err = c.UpdateDnsSettings(expected)
+	require.NoError(t, err)
+	assert.Empty(t, updated.Servers)
+}
@@ -1,6 +1,9 @@
 package client
 
-import "errors"
+import (
+	"errors"
+	"strings"
+)
 
 type NotFound struct {
 	s string
@@ -20,3 +23,40 @@ func IsNotFoundError(err error) bool {
 
 	return errors.As(err, &e) || errors.As(err, &ePtr)
 }
+
+// multiError holds errors of several independent operations, like errors.Join does in newer Go versions.
+type multiError struct {
+	errs []error
+}
+
+func (e multiError) Error() string {
+	messages := make([]string, len(e.errs))
+	for i, err := range e.errs {
+		messages[i] = err.Error()
+	}
+
+	return strings.Join(messages, "\n")
+}
+
+func (e multiError) Unwrap() []error {
+	return e.errs
+}
+
+// joinErrors returns an error wrapping all non-nil errors, or nil if there are none.
+func joinErrors(errs ...error) error {
+	result := []error{}
+	for _, err := range errs {
+		if err != nil {
+			result = append(result, err)
+		}
+	}
+
+	switch len(result) {
+	case 0:
+		return nil
+	case 1:
+		return result[0]
+	default:
+		return multiError{result}
+	}
+}
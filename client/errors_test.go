@@ -52,3 +52,16 @@ func TestIsNotFoundError(t *testing.T) {
 		})
 	}
 }
+
+func TestJoinErrors(t *testing.T) {
+	require.NoError(t, joinErrors())
+	require.NoError(t, joinErrors(nil, nil))
+
+	first := errors.New("first")
+	require.Equal(t, first, joinErrors(nil, first))
+
+	second := NewNotFound("second")
+	err := joinErrors(first, nil, second)
+	require.EqualError(t, err, "first\nsecond")
+	require.Equal(t, []error{first, second}, err.(multiError).Unwrap())
+}
@@ -0,0 +1,144 @@
+package client
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-routeros/routeros"
+)
+
+// exportReadChunkSize is the largest chunk /file/read returns at once.
+const exportReadChunkSize = 32768
+
+// ExportOptions defines arguments of /export command
+type ExportOptions struct {
+	// Path limits the export to a menu, e.g. "/ip/firewall". Empty value exports whole configuration.
+	Path          string
+	Compact       bool
+	Verbose       bool
+	HideSensitive bool
+	ShowSensitive bool
+}
+
+// Export holds result of /export command
+type Export struct {
+	Content string
+	Sha256  string
+}
+
+// Export runs /export command and returns the produced configuration script.
+//
+// RouterOS API does not return export output directly, so the script is written to a temporary file,
+// read back and removed afterwards. The /export command returns once the file is written.
+// Exports larger than /file/print reports contents of are read in chunks via /file/read.
+// The leading comment with the export timestamp is stripped, so the content hash only changes along with configuration.
+func (client Mikrotik) Export(opts ExportOptions) (*Export, error) {
+	c, err := client.getMikrotikClient()
+	if err != nil {
+		return nil, err
+	}
+
+	fileName := fmt.Sprintf("terraform-export-%d", time.Now().UTC().UnixNano())
+	cmd := []string{strings.TrimSuffix(opts.Path, "/") + "/export", "=file=" + fileName}
+	if opts.Compact {
+		cmd = append(cmd, "=compact=")
+	}
+	if opts.Verbose {
+		cmd = append(cmd, "=verbose=")
+	}
+	if opts.HideSensitive {
+		cmd = append(cmd, "=hide-sensitive=")
+	}
+	if opts.ShowSensitive {
+		cmd = append(cmd, "=show-sensitive=")
+	}
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	if _, err := c.RunArgs(cmd); err != nil {
+		return nil, err
+	}
+
+	fileName = fileName + ".rsc"
+	defer func() {
+		cmd := []string{"/file/remove", "=numbers=" + fileName}
+		log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+		if _, err := c.RunArgs(cmd); err != nil {
+			log.Printf("[WARN] could not remove export file %q: %v", fileName, err)
+		}
+	}()
+
+	cmd = []string{"/file/print", "?name=" + fileName, "=.proplist=contents,size"}
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	r, err := c.RunArgs(cmd)
+	if err != nil {
+		return nil, err
+	}
+	if len(r.Re) < 1 {
+		return nil, NewNotFound(fmt.Sprintf("export file %q not found", fileName))
+	}
+	contents := r.Re[0].Map["contents"]
+	size, err := strconv.Atoi(r.Re[0].Map["size"])
+	if err != nil {
+		return nil, fmt.Errorf("invalid size of export file %q: %w", fileName, err)
+	}
+	if len(contents) < size {
+		contents, err = readExportFile(c, fileName, size)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	content := stripExportHeader(contents)
+	sum := sha256.Sum256([]byte(content))
+
+	return &Export{
+		Content: content,
+		Sha256:  hex.EncodeToString(sum[:]),
+	}, nil
+}
+
+// readExportFile reads the export file in chunks via /file/read, which requires RouterOS v7.13 or later.
+func readExportFile(c *routeros.Client, name string, size int) (string, error) {
+	contents := make([]byte, 0, size)
+	for len(contents) < size {
+		cmd := []string{
+			"/file/read",
+			"=file=" + name,
+			"=offset=" + strconv.Itoa(len(contents)),
+			"=chunk-size=" + strconv.Itoa(exportReadChunkSize),
+		}
+		log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+		r, err := c.RunArgs(cmd)
+		if err != nil {
+			return "", err
+		}
+
+		data := r.Done.Map["data"]
+		if len(r.Re) > 0 {
+			data = r.Re[0].Map["data"]
+		}
+		if data == "" {
+			return "", fmt.Errorf("reading export file %q stopped at offset %d of %d", name, len(contents), size)
+		}
+		contents = append(contents, data...)
+	}
+
+	return string(contents), nil
+}
+
+// stripExportHeader removes the first comment line of the export which contains generation timestamp
+func stripExportHeader(content string) string {
+	firstLine, rest, found := strings.Cut(content, "\n")
+	if strings.HasPrefix(firstLine, "#") && strings.Contains(firstLine, " by RouterOS ") {
+		if !found {
+			return ""
+		}
+		return rest
+	}
+
+	return content
+}
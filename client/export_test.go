@@ -0,0 +1,61 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExport(t *testing.T) {
+	c := NewClient(GetConfigFromEnv())
+
+	export, err := c.Export(ExportOptions{Path: "/ip/dns"})
+	require.NoError(t, err)
+	assert.Contains(t, export.Content, "/ip dns")
+	assert.Len(t, export.Sha256, 64)
+
+	again, err := c.Export(ExportOptions{Path: "/ip/dns"})
+	require.NoError(t, err)
+	assert.Equal(t, export.Sha256, again.Sha256)
+}
+
+func TestExport_largerThanPrintedContents(t *testing.T) {
+	c := NewClient(GetConfigFromEnv())
+
+	export, err := c.Export(ExportOptions{Verbose: true})
+	require.NoError(t, err)
+	assert.Greater(t, len(export.Content), 4096)
+	assert.Contains(t, export.Content, "/ip dns")
+	assert.Contains(t, export.Content, "/system identity")
+}
+
+func TestStripExportHeader(t *testing.T) {
+	testCases := []struct {
+		name     string
+		content  string
+		expected string
+	}{
+		{
+			name:     "v7 header",
+			content:  "# 2023-05-01 10:00:00 by RouterOS 7.9\n# software id = \n/ip dns\nset servers=1.1.1.1\n",
+			expected: "# software id = \n/ip dns\nset servers=1.1.1.1\n",
+		},
+		{
+			name:     "v6 header",
+			content:  "# may/01/2023 10:00:00 by RouterOS 6.49.7\n/ip dns\n",
+			expected: "/ip dns\n",
+		},
+		{
+			name:     "no header",
+			content:  "/ip dns\nset servers=1.1.1.1\n",
+			expected: "/ip dns\nset servers=1.1.1.1\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, stripExportHeader(tc.content))
+		})
+	}
+}
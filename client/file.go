@@ -0,0 +1,263 @@
+package client
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-routeros/routeros"
+)
+
+const (
+	// fileWriteChunkSize is the largest contents written to a file at once, larger contents are uploaded in parts.
+	fileWriteChunkSize = 32768
+
+	// fileReadChunkSize is the largest chunk /file/read returns at once.
+	fileReadChunkSize = 32768
+)
+
+// File defines file stored on the router in /file menu
+//
+// Adding files with contents via /file/add is supported by RouterOS v7 only.
+// RouterOS reports contents of small files only, use ReadFileContents to get contents of larger ones.
+type File struct {
+	Id       string `mikrotik:".id" codegen:"id,mikrotikID"`
+	Name     string `mikrotik:"name" codegen:"name,terraformID,required"`
+	Contents string `mikrotik:"contents" codegen:"contents"`
+	Size     int    `mikrotik:"size,readonly" codegen:"size,computed"`
+	Type     string `mikrotik:"type,readonly" codegen:"type,computed"`
+}
+
+var _ Resource = (*File)(nil)
+
+func (b *File) ActionToCommand(a Action) string {
+	return map[Action]string{
+		Add:    "/file/add",
+		Find:   "/file/print",
+		Update: "/file/set",
+		Delete: "/file/remove",
+	}[a]
+}
+
+func (b *File) IDField() string {
+	return ".id"
+}
+
+func (b *File) ID() string {
+	return b.Id
+}
+
+func (b *File) SetID(id string) {
+	b.Id = id
+}
+
+func (b *File) AfterAddHook(r *routeros.Reply) {
+	b.Id = r.Done.Map["ret"]
+}
+
+func (b *File) FindField() string {
+	return "name"
+}
+
+func (b *File) FindFieldValue() string {
+	return b.Name
+}
+
+func (b *File) DeleteField() string {
+	return "numbers"
+}
+
+func (b *File) DeleteFieldValue() string {
+	return b.Name
+}
+
+// Typed wrappers
+func (c Mikrotik) AddFile(r *File) (*File, error) {
+	if len(r.Contents) > fileWriteChunkSize {
+		empty := *r
+		empty.Contents = ""
+		if _, err := c.Add(&empty); err != nil {
+			return nil, err
+		}
+		if err := c.uploadFileContents(r.Name, r.Contents); err != nil {
+			return nil, err
+		}
+
+		return c.FindFile(r.Name)
+	}
+	res, err := c.Add(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*File), nil
+}
+
+func (c Mikrotik) UpdateFile(r *File) (*File, error) {
+	if len(r.Contents) > fileWriteChunkSize {
+		if err := c.uploadFileContents(r.Name, r.Contents); err != nil {
+			return nil, err
+		}
+
+		return c.FindFile(r.Name)
+	}
+	res, err := c.Update(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*File), nil
+}
+
+func (c Mikrotik) FindFile(name string) (*File, error) {
+	res, err := c.Find(&File{Name: name})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*File), nil
+}
+
+func (c Mikrotik) DeleteFile(name string) error {
+	return c.Delete(&File{Name: name})
+}
+
+// SetFileContents replaces contents of the file, including setting them to an empty string.
+//
+// Contents larger than a single write are uploaded in parts, see uploadFileContents.
+func (c Mikrotik) SetFileContents(name, contents string) error {
+	if len(contents) > fileWriteChunkSize {
+		return c.uploadFileContents(name, contents)
+	}
+	client, err := c.getMikrotikClient()
+	if err != nil {
+		return err
+	}
+
+	cmd := []string{"/file/set", "=numbers=" + name, "=contents=" + contents}
+	log.Printf("[INFO] Running the mikrotik command: `%s` with %d bytes of contents", cmd[:2], len(contents))
+	_, err = client.RunArgs(cmd)
+
+	return err
+}
+
+// uploadFileContents replaces contents of the file with contents uploaded in parts.
+//
+// RouterOS has no command to append to a file, so each part is written to a numbered temporary file
+// and a script run on the router joins them into the target file.
+// The temporary files and the script are removed afterwards.
+func (c Mikrotik) uploadFileContents(name, contents string) error {
+	client, err := c.getMikrotikClient()
+	if err != nil {
+		return err
+	}
+
+	var parts []string
+	defer func() {
+		for _, part := range parts {
+			cmd := []string{"/file/remove", "=numbers=" + part}
+			log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+			if _, err := client.RunArgs(cmd); err != nil {
+				log.Printf("[WARN] Could not remove temporary file %q: %v", part, err)
+			}
+		}
+	}()
+	for offset := 0; offset < len(contents); offset += fileWriteChunkSize {
+		end := offset + fileWriteChunkSize
+		if end > len(contents) {
+			end = len(contents)
+		}
+		part := fmt.Sprintf("%s.part%d", name, len(parts))
+		cmd := []string{"/file/add", "=name=" + part, "=contents=" + contents[offset:end]}
+		log.Printf("[INFO] Running the mikrotik command: `%s` with %d bytes of contents", cmd[:2], end-offset)
+		if _, err := client.RunArgs(cmd); err != nil {
+			return err
+		}
+		parts = append(parts, part)
+	}
+
+	scriptName := fmt.Sprintf("terraform-file-join-%d", time.Now().UTC().UnixNano())
+	cmd := []string{"/system/script/add", "=name=" + scriptName, "=policy=read,write", "=source=" + fileJoinScript(name, parts)}
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	if _, err := client.RunArgs(cmd); err != nil {
+		return err
+	}
+	defer func() {
+		cmd := []string{"/system/script/remove", "=numbers=" + scriptName}
+		log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+		if _, err := client.RunArgs(cmd); err != nil {
+			log.Printf("[WARN] Could not remove temporary script %q: %v", scriptName, err)
+		}
+	}()
+
+	cmd = []string{"/system/script/run", "=number=" + scriptName}
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	if _, err := client.RunArgs(cmd); err != nil {
+		return err
+	}
+
+	joined, err := c.FindFile(name)
+	if err != nil {
+		return err
+	}
+	if joined.Size != len(contents) {
+		return fmt.Errorf("file %q has %d bytes after joining the uploaded parts, expected %d", name, joined.Size, len(contents))
+	}
+
+	return nil
+}
+
+// fileJoinScript returns RouterOS script, which sets contents of the file to the concatenated contents of parts.
+func fileJoinScript(name string, parts []string) string {
+	var b strings.Builder
+	b.WriteString(":local contents \"\"\n")
+	for _, part := range parts {
+		fmt.Fprintf(&b, ":set contents ($contents . [/file/get [find name=%s] contents])\n", scriptQuote(part))
+	}
+	fmt.Fprintf(&b, "/file/set [find name=%s] contents=$contents\n", scriptQuote(name))
+
+	return b.String()
+}
+
+// scriptQuote returns the value as a quoted string literal of RouterOS scripting language.
+func scriptQuote(value string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, `$`, `\$`).Replace(value) + `"`
+}
+
+// ReadFileContents reads contents of the file in chunks, so it works for files RouterOS does not report contents of.
+//
+// Reading files via /file/read requires RouterOS v7.13 or later.
+func (c Mikrotik) ReadFileContents(name string, size int) (string, error) {
+	client, err := c.getMikrotikClient()
+	if err != nil {
+		return "", err
+	}
+
+	contents := make([]byte, 0, size)
+	for len(contents) < size {
+		cmd := []string{
+			"/file/read",
+			"=file=" + name,
+			"=offset=" + strconv.Itoa(len(contents)),
+			"=chunk-size=" + strconv.Itoa(fileReadChunkSize),
+		}
+		log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+		r, err := client.RunArgs(cmd)
+		if err != nil {
+			return "", err
+		}
+
+		data := r.Done.Map["data"]
+		if len(r.Re) > 0 {
+			data = r.Re[0].Map["data"]
+		}
+		if data == "" {
+			return "", fmt.Errorf("reading file %q stopped at offset %d of %d", name, len(contents), size)
+		}
+		contents = append(contents, data...)
+	}
+
+	return string(contents), nil
+}
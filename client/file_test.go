@@ -0,0 +1,90 @@
+package client
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFile_basic(t *testing.T) {
+	SkipIfRouterOSV6OrEarlier(t, sysResources)
+	c := NewClient(GetConfigFromEnv())
+
+	file := &File{
+		Name:     "test-file-" + RandomString() + ".txt",
+		Contents: "first line\n",
+	}
+
+	created, err := c.AddFile(file)
+	require.NoError(t, err)
+	defer func(name string) {
+		assert.NoError(t, c.DeleteFile(name))
+	}(created.Name)
+
+	assert.Equal(t, file.Contents, created.Contents)
+	assert.Equal(t, len(file.Contents), created.Size)
+
+	file.Id = created.Id
+	file.Contents = "second line\n"
+	updated, err := c.UpdateFile(file)
+	require.NoError(t, err)
+	assert.Equal(t, file.Contents, updated.Contents)
+}
+
+func TestFile_readContentsInChunks(t *testing.T) {
+	SkipIfRouterOSV6OrEarlier(t, sysResources)
+	c := NewClient(GetConfigFromEnv())
+
+	created, err := c.AddFile(&File{Name: "test-file-" + RandomString() + ".txt"})
+	require.NoError(t, err)
+	defer func(name string) {
+		assert.NoError(t, c.DeleteFile(name))
+	}(created.Name)
+
+	// larger than a single chunk of /file/read
+	contents := strings.Repeat("0123456789abcdef\n", 3000)
+	require.NoError(t, c.SetFileContents(created.Name, contents))
+
+	found, err := c.FindFile(created.Name)
+	require.NoError(t, err)
+	assert.Equal(t, len(contents), found.Size)
+
+	read, err := c.ReadFileContents(found.Name, found.Size)
+	require.NoError(t, err)
+	assert.Equal(t, contents, read)
+}
+
+func TestFile_uploadContentsInParts(t *testing.T) {
+	SkipIfRouterOSV6OrEarlier(t, sysResources)
+	c := NewClient(GetConfigFromEnv())
+
+	// larger than a single write, so the contents are uploaded in parts
+	contents := strings.Repeat("0123456789abcdef\n", 8000)
+	created, err := c.AddFile(&File{Name: "test-file-" + RandomString() + ".txt", Contents: contents})
+	require.NoError(t, err)
+	defer func(name string) {
+		assert.NoError(t, c.DeleteFile(name))
+	}(created.Name)
+	assert.Equal(t, len(contents), created.Size)
+
+	read, err := c.ReadFileContents(created.Name, created.Size)
+	require.NoError(t, err)
+	assert.Equal(t, contents, read)
+
+	files, err := c.List(&File{})
+	require.NoError(t, err)
+	for _, f := range files {
+		assert.False(t, strings.HasPrefix(f.(*File).Name, created.Name+".part"), "temporary file %q is not removed", f.(*File).Name)
+	}
+}
+
+func TestFileJoinScript(t *testing.T) {
+	script := fileJoinScript(`dir/"quoted" $file.txt`, []string{"a.part0", "a.part1"})
+	assert.Equal(t, `:local contents ""
+:set contents ($contents . [/file/get [find name="a.part0"] contents])
+:set contents ($contents . [/file/get [find name="a.part1"] contents])
+/file/set [find name="dir/\"quoted\" \$file.txt"] contents=$contents
+`, script)
+}
@@ -0,0 +1,111 @@
+package client
+
+import (
+	"github.com/ddelnano/terraform-provider-mikrotik/client/types"
+	"github.com/go-routeros/routeros"
+)
+
+// FirewallAddressList defines /ip/firewall/address-list entry
+type FirewallAddressList struct {
+	Id           string                 `mikrotik:".id" codegen:"id,mikrotikID,terraformID"`
+	Address      string                 `mikrotik:"address" codegen:"address,required"`
+	Comment      string                 `mikrotik:"comment" codegen:"comment"`
+	CreationTime string                 `mikrotik:"creation-time,readonly" codegen:"creation_time,computed"`
+	Disabled     bool                   `mikrotik:"disabled" codegen:"disabled"`
+	Dynamic      bool                   `mikrotik:"dynamic,readonly" codegen:"dynamic,computed"`
+	List         string                 `mikrotik:"list" codegen:"list,required"`
+	Timeout      types.MikrotikDuration `mikrotik:"timeout" codegen:"timeout"`
+}
+
+var _ Resource = (*FirewallAddressList)(nil)
+
+func (b *FirewallAddressList) ActionToCommand(a Action) string {
+	return map[Action]string{
+		Add:    "/ip/firewall/address-list/add",
+		Find:   "/ip/firewall/address-list/print",
+		List:   "/ip/firewall/address-list/print",
+		Update: "/ip/firewall/address-list/set",
+		Delete: "/ip/firewall/address-list/remove",
+	}[a]
+}
+
+func (b *FirewallAddressList) IDField() string {
+	return ".id"
+}
+
+func (b *FirewallAddressList) ID() string {
+	return b.Id
+}
+
+func (b *FirewallAddressList) SetID(id string) {
+	b.Id = id
+}
+
+func (b *FirewallAddressList) AfterAddHook(r *routeros.Reply) {
+	b.Id = r.Done.Map["ret"]
+}
+
+func (c Mikrotik) AddFirewallAddressList(r *FirewallAddressList) (*FirewallAddressList, error) {
+	res, err := c.Add(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*FirewallAddressList), nil
+}
+
+func (c Mikrotik) UpdateFirewallAddressList(r *FirewallAddressList) (*FirewallAddressList, error) {
+	res, err := c.Update(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*FirewallAddressList), nil
+}
+
+func (c Mikrotik) FindFirewallAddressList(id string) (*FirewallAddressList, error) {
+	res, err := c.Find(&FirewallAddressList{Id: id})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*FirewallAddressList), nil
+}
+
+func (c Mikrotik) DeleteFirewallAddressList(id string) error {
+	return c.Delete(&FirewallAddressList{Id: id})
+}
+
+// ListFirewallAddressListEntries retrieves all entries of the named address list using single request
+func (c Mikrotik) ListFirewallAddressListEntries(list string) ([]FirewallAddressList, error) {
+	res, err := c.ListByField(&FirewallAddressList{}, "list", list)
+	if err != nil {
+		return nil, err
+	}
+	returnSlice := make([]FirewallAddressList, len(res))
+	for i, v := range res {
+		returnSlice[i] = *(v.(*FirewallAddressList))
+	}
+
+	return returnSlice, nil
+}
+
+// AddFirewallAddressListEntries creates multiple address list entries in a batch
+func (c Mikrotik) AddFirewallAddressListEntries(entries []FirewallAddressList) error {
+	items := make([]Resource, len(entries))
+	for i := range entries {
+		items[i] = &entries[i]
+	}
+
+	return c.AddMany(items)
+}
+
+// DeleteFirewallAddressListEntries removes multiple address list entries in a batch
+func (c Mikrotik) DeleteFirewallAddressListEntries(ids []string) error {
+	return c.DeleteMany(&FirewallAddressList{}, ids)
+}
+
+// SetFirewallAddressListEntriesComment sets comment of multiple address list entries in a batch
+func (c Mikrotik) SetFirewallAddressListEntriesComment(ids []string, comment string) error {
+	return c.SetMany(&FirewallAddressList{}, ids, "=comment="+comment)
+}
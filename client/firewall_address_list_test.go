@@ -0,0 +1,129 @@
+package client
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFirewallAddressList_basic(t *testing.T) {
+	c := NewClient(GetConfigFromEnv())
+
+	entry := &FirewallAddressList{
+		List:    "testacc-list",
+		Address: "10.10.10.1",
+		Comment: "Test entry",
+	}
+
+	created, err := c.AddFirewallAddressList(entry)
+	require.NoError(t, err)
+
+	defer func(id string) {
+		assert.NoError(t, c.DeleteFirewallAddressList(id))
+	}(created.Id)
+
+	entry.Id = created.Id
+	entry.CreationTime = created.CreationTime
+
+	found, err := c.FindFirewallAddressList(entry.Id)
+	require.NoError(t, err)
+	assert.Equal(t, entry, found)
+
+	entry.Address = "10.10.10.0/24"
+	updated, err := c.UpdateFirewallAddressList(entry)
+	require.NoError(t, err)
+	assert.Equal(t, "10.10.10.0/24", updated.Address)
+}
+
+func TestFirewallAddressList_timeout(t *testing.T) {
+	c := NewClient(GetConfigFromEnv())
+
+	created, err := c.AddFirewallAddressList(&FirewallAddressList{
+		List:    "testacc-list",
+		Address: "10.10.10.2",
+		Timeout: 3600,
+	})
+	require.NoError(t, err)
+
+	defer func(id string) {
+		assert.NoError(t, c.DeleteFirewallAddressList(id))
+	}(created.Id)
+
+	assert.True(t, created.Dynamic)
+	assert.LessOrEqual(t, int(created.Timeout), 3600)
+	assert.Greater(t, int(created.Timeout), 0)
+}
+
+func TestFirewallAddressList_batch(t *testing.T) {
+	c := NewClient(GetConfigFromEnv())
+	list := "testacc-batch-list"
+
+	entries := []FirewallAddressList{}
+	expected := []string{}
+	for i := 1; i <= 250; i++ {
+		address := fmt.Sprintf("10.20.%d.%d", i/200, i%200)
+		entries = append(entries, FirewallAddressList{List: list, Address: address})
+		expected = append(expected, address)
+	}
+	require.NoError(t, c.AddFirewallAddressListEntries(entries))
+
+	found, err := c.ListFirewallAddressListEntries(list)
+	require.NoError(t, err)
+
+	actual := []string{}
+	ids := []string{}
+	for _, e := range found {
+		actual = append(actual, e.Address)
+		ids = append(ids, e.Id)
+	}
+	sort.Strings(expected)
+	sort.Strings(actual)
+	assert.Equal(t, expected, actual)
+
+	require.NoError(t, c.SetFirewallAddressListEntriesComment(ids, "batch comment"))
+	found, err = c.ListFirewallAddressListEntries(list)
+	require.NoError(t, err)
+	for _, e := range found {
+		assert.Equal(t, "batch comment", e.Comment)
+	}
+
+	require.NoError(t, c.DeleteFirewallAddressListEntries(ids))
+
+	found, err = c.ListFirewallAddressListEntries(list)
+	require.NoError(t, err)
+	assert.Empty(t, found)
+}
+
+func TestFirewallAddressList_batchPartialFailure(t *testing.T) {
+	c := NewClient(GetConfigFromEnv())
+	list := "testacc-batch-partial-list"
+
+	// duplicates are rejected by RouterOS, while other entries must still be added
+	entries := []FirewallAddressList{
+		{List: list, Address: "10.30.0.1"},
+		{List: list, Address: "10.30.0.2"},
+		{List: list, Address: "10.30.0.2"},
+		{List: list, Address: "10.30.0.3"},
+		{List: list, Address: "10.30.0.3"},
+	}
+	err := c.AddFirewallAddressListEntries(entries)
+	require.Error(t, err)
+	assert.Len(t, err.(multiError).Unwrap(), 2)
+
+	found, err := c.ListFirewallAddressListEntries(list)
+	require.NoError(t, err)
+
+	actual := []string{}
+	ids := []string{}
+	for _, e := range found {
+		actual = append(actual, e.Address)
+		ids = append(ids, e.Id)
+	}
+	sort.Strings(actual)
+	assert.Equal(t, []string{"10.30.0.1", "10.30.0.2", "10.30.0.3"}, actual)
+
+	require.NoError(t, c.DeleteFirewallAddressListEntries(ids))
+}
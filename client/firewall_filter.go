@@ -7,16 +7,36 @@ import (
 
 // FirewallFilterRule defines /ip/firewall/filter rule
 type FirewallFilterRule struct {
-	Id               string             `mikrotik:".id" codegen:"id,mikrotikID,terraformID"`
-	Action           string             `mikrotik:"action" codegen:"action"`
-	Chain            string             `mikrotik:"chain" codegen:"chain,required"`
-	Comment          string             `mikrotik:"comment" codegen:"comment"`
-	ConnectionState  types.MikrotikList `mikrotik:"connection-state" codegen:"connection_state"`
-	DestPort         string             `mikrotik:"dst-port" codegen:"dst_port"`
-	InInterface      string             `mikrotik:"in-interface" codegen:"in_interface"`
-	InInterfaceList  string             `mikrotik:"in-interface-list" codegen:"in_interface_list"`
-	OutInterfaceList string             `mikrotik:"out-interface-list" codegen:"out_interface_list"`
-	Protocol         string             `mikrotik:"protocol" codegen:"protocol"`
+	Id                       string             `mikrotik:".id" codegen:"id,mikrotikID,terraformID"`
+	Action                   string             `mikrotik:"action" codegen:"action"`
+	Chain                    string             `mikrotik:"chain" codegen:"chain,required"`
+	Comment                  string             `mikrotik:"comment" codegen:"comment"`
+	ConnectionMark           string             `mikrotik:"connection-mark" codegen:"connection_mark"`
+	ConnectionNatState       types.MikrotikList `mikrotik:"connection-nat-state" codegen:"connection_nat_state"`
+	ConnectionNatStateNegate bool               `mikrotik:"connection-nat-state,negate" codegen:"connection_nat_state_negate"`
+	ConnectionState          types.MikrotikList `mikrotik:"connection-state" codegen:"connection_state"`
+	ConnectionStateNegate    bool               `mikrotik:"connection-state,negate" codegen:"connection_state_negate"`
+	DestAddress              string             `mikrotik:"dst-address" codegen:"dst_address"`
+	DestAddressList          string             `mikrotik:"dst-address-list" codegen:"dst_address_list"`
+	DestPort                 string             `mikrotik:"dst-port" codegen:"dst_port"`
+	Disabled                 bool               `mikrotik:"disabled" codegen:"disabled"`
+	Dynamic                  bool               `mikrotik:"dynamic,readonly" codegen:"dynamic,computed"`
+	IcmpOptions              string             `mikrotik:"icmp-options" codegen:"icmp_options"`
+	InInterface              string             `mikrotik:"in-interface" codegen:"in_interface"`
+	InInterfaceList          string             `mikrotik:"in-interface-list" codegen:"in_interface_list"`
+	JumpTarget               string             `mikrotik:"jump-target" codegen:"jump_target"`
+	Limit                    string             `mikrotik:"limit" codegen:"limit"`
+	Log                      bool               `mikrotik:"log" codegen:"log"`
+	LogPrefix                string             `mikrotik:"log-prefix" codegen:"log_prefix"`
+	OutInterface             string             `mikrotik:"out-interface" codegen:"out_interface"`
+	OutInterfaceList         string             `mikrotik:"out-interface-list" codegen:"out_interface_list"`
+	PacketMark               string             `mikrotik:"packet-mark" codegen:"packet_mark"`
+	Protocol                 string             `mikrotik:"protocol" codegen:"protocol"`
+	RejectWith               string             `mikrotik:"reject-with" codegen:"reject_with"`
+	SrcAddress               string             `mikrotik:"src-address" codegen:"src_address"`
+	SrcAddressList           string             `mikrotik:"src-address-list" codegen:"src_address_list"`
+	SrcPort                  string             `mikrotik:"src-port" codegen:"src_port"`
+	TcpFlags                 types.MikrotikList `mikrotik:"tcp-flags" codegen:"tcp_flags"`
 }
 
 var _ Resource = (*FirewallFilterRule)(nil)
@@ -25,8 +45,10 @@ func (b *FirewallFilterRule) ActionToCommand(a Action) string {
 	return map[Action]string{
 		Add:    "/ip/firewall/filter/add",
 		Find:   "/ip/firewall/filter/print",
+		List:   "/ip/firewall/filter/print",
 		Update: "/ip/firewall/filter/set",
 		Delete: "/ip/firewall/filter/remove",
+		Move:   "/ip/firewall/filter/move",
 	}[a]
 }
 
@@ -73,6 +95,24 @@ func (c Mikrotik) FindFirewallFilterRule(id string) (*FirewallFilterRule, error)
 	return res.(*FirewallFilterRule), nil
 }
 
+func (c Mikrotik) ListFirewallFilterRules() ([]FirewallFilterRule, error) {
+	res, err := c.List(&FirewallFilterRule{})
+	if err != nil {
+		return nil, err
+	}
+	returnSlice := make([]FirewallFilterRule, len(res))
+	for i, v := range res {
+		returnSlice[i] = *(v.(*FirewallFilterRule))
+	}
+
+	return returnSlice, nil
+}
+
+// MoveFirewallFilterRule places the rule right before destination rule
+func (c Mikrotik) MoveFirewallFilterRule(id, destinationID string) error {
+	return c.Move(&FirewallFilterRule{Id: id}, destinationID)
+}
+
 func (c Mikrotik) DeleteFirewallFilterRule(id string) error {
 	return c.Delete(&FirewallFilterRule{Id: id})
 }
@@ -66,3 +66,111 @@ func TestFirewallFilter_builtinChain(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, rule, foundRule)
 }
+
+func TestFirewallFilter_allMatchers(t *testing.T) {
+	c := NewClient(GetConfigFromEnv())
+
+	rule := &FirewallFilterRule{
+		Action:                   "jump",
+		Chain:                    "mychain",
+		Comment:                  "Test rule with all matchers",
+		ConnectionMark:           "no-mark",
+		ConnectionNatState:       types.MikrotikList{"dstnat"},
+		ConnectionNatStateNegate: true,
+		ConnectionState:          types.MikrotikList{"new", "invalid"},
+		ConnectionStateNegate:    true,
+		DestAddress:              "10.0.0.0/8",
+		DestAddressList:          "servers",
+		DestPort:                 "443",
+		Disabled:                 true,
+		InInterface:              "ether1",
+		JumpTarget:               "mychain2",
+		Limit:                    "10,5:packet",
+		Log:                      true,
+		LogPrefix:                "tf-test",
+		OutInterface:             "ether2",
+		PacketMark:               "no-mark",
+		Protocol:                 "tcp",
+		SrcAddress:               "!192.168.88.0/24",
+		SrcAddressList:           "!trusted",
+		SrcPort:                  "1024-65535",
+		TcpFlags:                 types.MikrotikList{"syn", "!ack"},
+	}
+
+	createdRule, err := c.AddFirewallFilterRule(rule)
+	require.NoError(t, err)
+
+	defer func(id string) {
+		assert.NoError(t, c.DeleteFirewallFilterRule(id))
+	}(createdRule.Id)
+
+	rule.Id = createdRule.Id
+	assert.Equal(t, rule, createdRule)
+
+	rule.Log = false
+	rule.LogPrefix = "tf-test-updated"
+	rule.TcpFlags = types.MikrotikList{"syn"}
+	_, err = c.UpdateFirewallFilterRule(rule)
+	require.NoError(t, err)
+
+	foundRule, err := c.FindFirewallFilterRule(rule.Id)
+	require.NoError(t, err)
+	assert.Equal(t, rule, foundRule)
+}
+
+func TestFirewallFilter_icmpReject(t *testing.T) {
+	c := NewClient(GetConfigFromEnv())
+
+	rule := &FirewallFilterRule{
+		Action:      "reject",
+		Chain:       "mychain",
+		Disabled:    true,
+		IcmpOptions: "8:0",
+		Protocol:    "icmp",
+		RejectWith:  "icmp-admin-prohibited",
+	}
+
+	createdRule, err := c.AddFirewallFilterRule(rule)
+	require.NoError(t, err)
+
+	defer func(id string) {
+		assert.NoError(t, c.DeleteFirewallFilterRule(id))
+	}(createdRule.Id)
+
+	rule.Id = createdRule.Id
+	assert.Equal(t, rule, createdRule)
+}
+
+func TestFirewallFilter_move(t *testing.T) {
+	c := NewClient(GetConfigFromEnv())
+
+	first, err := c.AddFirewallFilterRule(&FirewallFilterRule{Chain: "mychain", Comment: "first", Disabled: true})
+	require.NoError(t, err)
+	defer func(id string) {
+		assert.NoError(t, c.DeleteFirewallFilterRule(id))
+	}(first.Id)
+
+	second, err := c.AddFirewallFilterRule(&FirewallFilterRule{Chain: "mychain", Comment: "second", Disabled: true})
+	require.NoError(t, err)
+	defer func(id string) {
+		assert.NoError(t, c.DeleteFirewallFilterRule(id))
+	}(second.Id)
+
+	require.NoError(t, c.MoveFirewallFilterRule(second.Id, first.Id))
+
+	rules, err := c.ListFirewallFilterRules()
+	require.NoError(t, err)
+
+	positions := map[string]int{}
+	for i, r := range rules {
+		positions[r.Id] = i
+	}
+	assert.Less(t, positions[second.Id], positions[first.Id])
+
+	ids, err := c.ListIDs(&FirewallFilterRule{}, first.Id, "*ffffff", second.Id)
+	require.NoError(t, err)
+	assert.Equal(t, []string{second.Id, first.Id}, ids)
+
+	err = c.MoveFirewallFilterRule("*ffffff", first.Id)
+	assert.True(t, IsNotFoundError(err), "expected to get NotFound error, got %v", err)
+}
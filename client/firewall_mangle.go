@@ -0,0 +1,136 @@
+package client
+
+import (
+	"strings"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client/types"
+	"github.com/go-routeros/routeros"
+)
+
+// FirewallMangleRule defines /ip/firewall/mangle rule
+type FirewallMangleRule struct {
+	Id                       string             `mikrotik:".id" codegen:"id,mikrotikID,terraformID"`
+	Action                   string             `mikrotik:"action" codegen:"action"`
+	Chain                    string             `mikrotik:"chain" codegen:"chain,required"`
+	Comment                  string             `mikrotik:"comment" codegen:"comment"`
+	ConnectionMark           string             `mikrotik:"connection-mark" codegen:"connection_mark"`
+	ConnectionNatState       types.MikrotikList `mikrotik:"connection-nat-state" codegen:"connection_nat_state"`
+	ConnectionNatStateNegate bool               `mikrotik:"connection-nat-state,negate" codegen:"connection_nat_state_negate"`
+	ConnectionState          types.MikrotikList `mikrotik:"connection-state" codegen:"connection_state"`
+	ConnectionStateNegate    bool               `mikrotik:"connection-state,negate" codegen:"connection_state_negate"`
+	DestAddress              string             `mikrotik:"dst-address" codegen:"dst_address"`
+	DestAddressList          string             `mikrotik:"dst-address-list" codegen:"dst_address_list"`
+	DestPort                 string             `mikrotik:"dst-port" codegen:"dst_port"`
+	Disabled                 bool               `mikrotik:"disabled" codegen:"disabled"`
+	Dynamic                  bool               `mikrotik:"dynamic,readonly" codegen:"dynamic,computed"`
+	IcmpOptions              string             `mikrotik:"icmp-options" codegen:"icmp_options"`
+	InInterface              string             `mikrotik:"in-interface" codegen:"in_interface"`
+	InInterfaceList          string             `mikrotik:"in-interface-list" codegen:"in_interface_list"`
+	JumpTarget               string             `mikrotik:"jump-target" codegen:"jump_target"`
+	Limit                    string             `mikrotik:"limit" codegen:"limit"`
+	Log                      bool               `mikrotik:"log" codegen:"log"`
+	LogPrefix                string             `mikrotik:"log-prefix" codegen:"log_prefix"`
+	NewConnectionMark        string             `mikrotik:"new-connection-mark" codegen:"new_connection_mark"`
+	NewMss                   string             `mikrotik:"new-mss" codegen:"new_mss"`
+	NewPacketMark            string             `mikrotik:"new-packet-mark" codegen:"new_packet_mark"`
+	NewRoutingMark           string             `mikrotik:"new-routing-mark" codegen:"new_routing_mark"`
+	OutInterface             string             `mikrotik:"out-interface" codegen:"out_interface"`
+	OutInterfaceList         string             `mikrotik:"out-interface-list" codegen:"out_interface_list"`
+	PacketMark               string             `mikrotik:"packet-mark" codegen:"packet_mark"`
+	Passthrough              bool               `mikrotik:"passthrough" codegen:"passthrough"`
+	Protocol                 string             `mikrotik:"protocol" codegen:"protocol"`
+	RoutingMark              string             `mikrotik:"routing-mark" codegen:"routing_mark"`
+	SrcAddress               string             `mikrotik:"src-address" codegen:"src_address"`
+	SrcAddressList           string             `mikrotik:"src-address-list" codegen:"src_address_list"`
+	SrcPort                  string             `mikrotik:"src-port" codegen:"src_port"`
+	TcpFlags                 types.MikrotikList `mikrotik:"tcp-flags" codegen:"tcp_flags"`
+}
+
+var _ Resource = (*FirewallMangleRule)(nil)
+
+func (b *FirewallMangleRule) ActionToCommand(a Action) string {
+	return map[Action]string{
+		Add:    "/ip/firewall/mangle/add",
+		Find:   "/ip/firewall/mangle/print",
+		List:   "/ip/firewall/mangle/print",
+		Update: "/ip/firewall/mangle/set",
+		Delete: "/ip/firewall/mangle/remove",
+		Move:   "/ip/firewall/mangle/move",
+	}[a]
+}
+
+func (b *FirewallMangleRule) IDField() string {
+	return ".id"
+}
+
+func (b *FirewallMangleRule) ID() string {
+	return b.Id
+}
+
+func (b *FirewallMangleRule) SetID(id string) {
+	b.Id = id
+}
+
+func (b *FirewallMangleRule) AfterAddHook(r *routeros.Reply) {
+	b.Id = r.Done.Map["ret"]
+}
+
+// OmitMikrotikProperty skips `passthrough` for actions which do not use it.
+func (b *FirewallMangleRule) OmitMikrotikProperty(name string) bool {
+	return name == "passthrough" && !MangleActionUsesPassthrough(b.Action)
+}
+
+// MangleActionUsesPassthrough reports whether the mangle action supports `passthrough` property.
+// RouterOS uses it only for marking and changing actions.
+func MangleActionUsesPassthrough(action string) bool {
+	return strings.HasPrefix(action, "mark-") || strings.HasPrefix(action, "change-")
+}
+
+func (c Mikrotik) AddFirewallMangleRule(r *FirewallMangleRule) (*FirewallMangleRule, error) {
+	res, err := c.Add(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*FirewallMangleRule), nil
+}
+
+func (c Mikrotik) UpdateFirewallMangleRule(r *FirewallMangleRule) (*FirewallMangleRule, error) {
+	res, err := c.Update(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*FirewallMangleRule), nil
+}
+
+func (c Mikrotik) FindFirewallMangleRule(id string) (*FirewallMangleRule, error) {
+	res, err := c.Find(&FirewallMangleRule{Id: id})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*FirewallMangleRule), nil
+}
+
+func (c Mikrotik) ListFirewallMangleRules() ([]FirewallMangleRule, error) {
+	res, err := c.List(&FirewallMangleRule{})
+	if err != nil {
+		return nil, err
+	}
+	returnSlice := make([]FirewallMangleRule, len(res))
+	for i, v := range res {
+		returnSlice[i] = *(v.(*FirewallMangleRule))
+	}
+
+	return returnSlice, nil
+}
+
+// MoveFirewallMangleRule places the rule right before destination rule
+func (c Mikrotik) MoveFirewallMangleRule(id, destinationID string) error {
+	return c.Move(&FirewallMangleRule{Id: id}, destinationID)
+}
+
+func (c Mikrotik) DeleteFirewallMangleRule(id string) error {
+	return c.Delete(&FirewallMangleRule{Id: id})
+}
@@ -0,0 +1,70 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFirewallMangle_markRouting(t *testing.T) {
+	c := NewClient(GetConfigFromEnv())
+
+	rule := &FirewallMangleRule{
+		Action:          "mark-routing",
+		Chain:           "prerouting",
+		Comment:         "Test policy routing",
+		ConnectionState: types.MikrotikList{"new"},
+		NewRoutingMark:  "main",
+		SrcAddressList:  "via-isp2",
+		Passthrough:     true,
+		Disabled:        true,
+	}
+
+	createdRule, err := c.AddFirewallMangleRule(rule)
+	require.NoError(t, err)
+
+	defer func(id string) {
+		assert.NoError(t, c.DeleteFirewallMangleRule(id))
+	}(createdRule.Id)
+
+	rule.Id = createdRule.Id
+
+	foundRule, err := c.FindFirewallMangleRule(rule.Id)
+	require.NoError(t, err)
+	assert.Equal(t, rule, foundRule)
+
+	rule.Passthrough = false
+	updatedRule, err := c.UpdateFirewallMangleRule(rule)
+	require.NoError(t, err)
+	assert.Equal(t, rule, updatedRule)
+}
+
+func TestFirewallMangle_changeMss(t *testing.T) {
+	c := NewClient(GetConfigFromEnv())
+
+	rule := &FirewallMangleRule{
+		Action:       "change-mss",
+		Chain:        "forward",
+		NewMss:       "clamp-to-pmtu",
+		OutInterface: "ether1",
+		Protocol:     "tcp",
+		TcpFlags:     types.MikrotikList{"syn"},
+		Passthrough:  true,
+		Disabled:     true,
+	}
+
+	createdRule, err := c.AddFirewallMangleRule(rule)
+	require.NoError(t, err)
+
+	defer func(id string) {
+		assert.NoError(t, c.DeleteFirewallMangleRule(id))
+	}(createdRule.Id)
+
+	rule.Id = createdRule.Id
+
+	foundRule, err := c.FindFirewallMangleRule(rule.Id)
+	require.NoError(t, err)
+	assert.Equal(t, rule, foundRule)
+}
@@ -0,0 +1,122 @@
+package client
+
+import (
+	"github.com/ddelnano/terraform-provider-mikrotik/client/types"
+	"github.com/go-routeros/routeros"
+)
+
+// FirewallNatRule defines /ip/firewall/nat rule
+type FirewallNatRule struct {
+	Id                       string             `mikrotik:".id" codegen:"id,mikrotikID,terraformID"`
+	Action                   string             `mikrotik:"action" codegen:"action"`
+	Chain                    string             `mikrotik:"chain" codegen:"chain,required"`
+	Comment                  string             `mikrotik:"comment" codegen:"comment"`
+	ConnectionMark           string             `mikrotik:"connection-mark" codegen:"connection_mark"`
+	ConnectionNatState       types.MikrotikList `mikrotik:"connection-nat-state" codegen:"connection_nat_state"`
+	ConnectionNatStateNegate bool               `mikrotik:"connection-nat-state,negate" codegen:"connection_nat_state_negate"`
+	ConnectionState          types.MikrotikList `mikrotik:"connection-state" codegen:"connection_state"`
+	ConnectionStateNegate    bool               `mikrotik:"connection-state,negate" codegen:"connection_state_negate"`
+	DestAddress              string             `mikrotik:"dst-address" codegen:"dst_address"`
+	DestAddressList          string             `mikrotik:"dst-address-list" codegen:"dst_address_list"`
+	DestAddressType          string             `mikrotik:"dst-address-type" codegen:"dst_address_type"`
+	DestPort                 string             `mikrotik:"dst-port" codegen:"dst_port"`
+	Disabled                 bool               `mikrotik:"disabled" codegen:"disabled"`
+	Dynamic                  bool               `mikrotik:"dynamic,readonly" codegen:"dynamic,computed"`
+	IcmpOptions              string             `mikrotik:"icmp-options" codegen:"icmp_options"`
+	InInterface              string             `mikrotik:"in-interface" codegen:"in_interface"`
+	InInterfaceList          string             `mikrotik:"in-interface-list" codegen:"in_interface_list"`
+	JumpTarget               string             `mikrotik:"jump-target" codegen:"jump_target"`
+	Limit                    string             `mikrotik:"limit" codegen:"limit"`
+	Log                      bool               `mikrotik:"log" codegen:"log"`
+	LogPrefix                string             `mikrotik:"log-prefix" codegen:"log_prefix"`
+	OutInterface             string             `mikrotik:"out-interface" codegen:"out_interface"`
+	OutInterfaceList         string             `mikrotik:"out-interface-list" codegen:"out_interface_list"`
+	PacketMark               string             `mikrotik:"packet-mark" codegen:"packet_mark"`
+	Protocol                 string             `mikrotik:"protocol" codegen:"protocol"`
+	RoutingMark              string             `mikrotik:"routing-mark" codegen:"routing_mark"`
+	SrcAddress               string             `mikrotik:"src-address" codegen:"src_address"`
+	SrcAddressList           string             `mikrotik:"src-address-list" codegen:"src_address_list"`
+	SrcAddressType           string             `mikrotik:"src-address-type" codegen:"src_address_type"`
+	SrcPort                  string             `mikrotik:"src-port" codegen:"src_port"`
+	TcpFlags                 types.MikrotikList `mikrotik:"tcp-flags" codegen:"tcp_flags"`
+	ToAddresses              string             `mikrotik:"to-addresses" codegen:"to_addresses"`
+	ToPorts                  string             `mikrotik:"to-ports" codegen:"to_ports"`
+}
+
+var _ Resource = (*FirewallNatRule)(nil)
+
+func (b *FirewallNatRule) ActionToCommand(a Action) string {
+	return map[Action]string{
+		Add:    "/ip/firewall/nat/add",
+		Find:   "/ip/firewall/nat/print",
+		List:   "/ip/firewall/nat/print",
+		Update: "/ip/firewall/nat/set",
+		Delete: "/ip/firewall/nat/remove",
+		Move:   "/ip/firewall/nat/move",
+	}[a]
+}
+
+func (b *FirewallNatRule) IDField() string {
+	return ".id"
+}
+
+func (b *FirewallNatRule) ID() string {
+	return b.Id
+}
+
+func (b *FirewallNatRule) SetID(id string) {
+	b.Id = id
+}
+
+func (b *FirewallNatRule) AfterAddHook(r *routeros.Reply) {
+	b.Id = r.Done.Map["ret"]
+}
+
+func (c Mikrotik) AddFirewallNatRule(r *FirewallNatRule) (*FirewallNatRule, error) {
+	res, err := c.Add(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*FirewallNatRule), nil
+}
+
+func (c Mikrotik) UpdateFirewallNatRule(r *FirewallNatRule) (*FirewallNatRule, error) {
+	res, err := c.Update(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*FirewallNatRule), nil
+}
+
+func (c Mikrotik) FindFirewallNatRule(id string) (*FirewallNatRule, error) {
+	res, err := c.Find(&FirewallNatRule{Id: id})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*FirewallNatRule), nil
+}
+
+func (c Mikrotik) ListFirewallNatRules() ([]FirewallNatRule, error) {
+	res, err := c.List(&FirewallNatRule{})
+	if err != nil {
+		return nil, err
+	}
+	returnSlice := make([]FirewallNatRule, len(res))
+	for i, v := range res {
+		returnSlice[i] = *(v.(*FirewallNatRule))
+	}
+
+	return returnSlice, nil
+}
+
+// MoveFirewallNatRule places the rule right before destination rule
+func (c Mikrotik) MoveFirewallNatRule(id, destinationID string) error {
+	return c.Move(&FirewallNatRule{Id: id}, destinationID)
+}
+
+func (c Mikrotik) DeleteFirewallNatRule(id string) error {
+	return c.Delete(&FirewallNatRule{Id: id})
+}
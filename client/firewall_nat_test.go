@@ -0,0 +1,80 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFirewallNat_portForward(t *testing.T) {
+	c := NewClient(GetConfigFromEnv())
+
+	rule := &FirewallNatRule{
+		Action:          "dst-nat",
+		Chain:           "dstnat",
+		Comment:         "Test port forward",
+		DestPort:        "8080",
+		InInterfaceList: "all",
+		Protocol:        "tcp",
+		ToAddresses:     "192.168.88.10",
+		ToPorts:         "80",
+		Disabled:        true,
+	}
+
+	createdRule, err := c.AddFirewallNatRule(rule)
+	require.NoError(t, err)
+
+	defer func(id string) {
+		assert.NoError(t, c.DeleteFirewallNatRule(id))
+	}(createdRule.Id)
+
+	rule.Id = createdRule.Id
+
+	foundRule, err := c.FindFirewallNatRule(rule.Id)
+	require.NoError(t, err)
+	assert.Equal(t, rule, foundRule)
+
+	rule.ToPorts = "8000"
+	updatedRule, err := c.UpdateFirewallNatRule(rule)
+	require.NoError(t, err)
+	assert.Equal(t, rule, updatedRule)
+}
+
+func TestFirewallNat_masqueradeAndMove(t *testing.T) {
+	c := NewClient(GetConfigFromEnv())
+
+	masquerade, err := c.AddFirewallNatRule(&FirewallNatRule{
+		Action:           "masquerade",
+		Chain:            "srcnat",
+		OutInterfaceList: "all",
+		SrcAddressList:   "local",
+		Disabled:         true,
+	})
+	require.NoError(t, err)
+	defer func(id string) {
+		assert.NoError(t, c.DeleteFirewallNatRule(id))
+	}(masquerade.Id)
+
+	bypass, err := c.AddFirewallNatRule(&FirewallNatRule{
+		Action:          "accept",
+		Chain:           "srcnat",
+		DestAddressList: "vpn",
+		Disabled:        true,
+	})
+	require.NoError(t, err)
+	defer func(id string) {
+		assert.NoError(t, c.DeleteFirewallNatRule(id))
+	}(bypass.Id)
+
+	require.NoError(t, c.MoveFirewallNatRule(bypass.Id, masquerade.Id))
+
+	rules, err := c.ListFirewallNatRules()
+	require.NoError(t, err)
+
+	positions := map[string]int{}
+	for i, r := range rules {
+		positions[r.Id] = i
+	}
+	assert.Less(t, positions[bypass.Id], positions[masquerade.Id])
+}
@@ -0,0 +1,111 @@
+package client
+
+import (
+	"github.com/ddelnano/terraform-provider-mikrotik/client/types"
+	"github.com/go-routeros/routeros"
+)
+
+// FirewallRawRule defines /ip/firewall/raw rule
+type FirewallRawRule struct {
+	Id               string             `mikrotik:".id" codegen:"id,mikrotikID,terraformID"`
+	Action           string             `mikrotik:"action" codegen:"action"`
+	Chain            string             `mikrotik:"chain" codegen:"chain,required"`
+	Comment          string             `mikrotik:"comment" codegen:"comment"`
+	DestAddress      string             `mikrotik:"dst-address" codegen:"dst_address"`
+	DestAddressList  string             `mikrotik:"dst-address-list" codegen:"dst_address_list"`
+	DestPort         string             `mikrotik:"dst-port" codegen:"dst_port"`
+	Disabled         bool               `mikrotik:"disabled" codegen:"disabled"`
+	Dynamic          bool               `mikrotik:"dynamic,readonly" codegen:"dynamic,computed"`
+	IcmpOptions      string             `mikrotik:"icmp-options" codegen:"icmp_options"`
+	InInterface      string             `mikrotik:"in-interface" codegen:"in_interface"`
+	InInterfaceList  string             `mikrotik:"in-interface-list" codegen:"in_interface_list"`
+	JumpTarget       string             `mikrotik:"jump-target" codegen:"jump_target"`
+	Limit            string             `mikrotik:"limit" codegen:"limit"`
+	Log              bool               `mikrotik:"log" codegen:"log"`
+	LogPrefix        string             `mikrotik:"log-prefix" codegen:"log_prefix"`
+	OutInterface     string             `mikrotik:"out-interface" codegen:"out_interface"`
+	OutInterfaceList string             `mikrotik:"out-interface-list" codegen:"out_interface_list"`
+	Protocol         string             `mikrotik:"protocol" codegen:"protocol"`
+	SrcAddress       string             `mikrotik:"src-address" codegen:"src_address"`
+	SrcAddressList   string             `mikrotik:"src-address-list" codegen:"src_address_list"`
+	SrcPort          string             `mikrotik:"src-port" codegen:"src_port"`
+	TcpFlags         types.MikrotikList `mikrotik:"tcp-flags" codegen:"tcp_flags"`
+}
+
+var _ Resource = (*FirewallRawRule)(nil)
+
+func (b *FirewallRawRule) ActionToCommand(a Action) string {
+	return map[Action]string{
+		Add:    "/ip/firewall/raw/add",
+		Find:   "/ip/firewall/raw/print",
+		List:   "/ip/firewall/raw/print",
+		Update: "/ip/firewall/raw/set",
+		Delete: "/ip/firewall/raw/remove",
+		Move:   "/ip/firewall/raw/move",
+	}[a]
+}
+
+func (b *FirewallRawRule) IDField() string {
+	return ".id"
+}
+
+func (b *FirewallRawRule) ID() string {
+	return b.Id
+}
+
+func (b *FirewallRawRule) SetID(id string) {
+	b.Id = id
+}
+
+func (b *FirewallRawRule) AfterAddHook(r *routeros.Reply) {
+	b.Id = r.Done.Map["ret"]
+}
+
+func (c Mikrotik) AddFirewallRawRule(r *FirewallRawRule) (*FirewallRawRule, error) {
+	res, err := c.Add(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*FirewallRawRule), nil
+}
+
+func (c Mikrotik) UpdateFirewallRawRule(r *FirewallRawRule) (*FirewallRawRule, error) {
+	res, err := c.Update(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*FirewallRawRule), nil
+}
+
+func (c Mikrotik) FindFirewallRawRule(id string) (*FirewallRawRule, error) {
+	res, err := c.Find(&FirewallRawRule{Id: id})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*FirewallRawRule), nil
+}
+
+func (c Mikrotik) ListFirewallRawRules() ([]FirewallRawRule, error) {
+	res, err := c.List(&FirewallRawRule{})
+	if err != nil {
+		return nil, err
+	}
+	returnSlice := make([]FirewallRawRule, len(res))
+	for i, v := range res {
+		returnSlice[i] = *(v.(*FirewallRawRule))
+	}
+
+	return returnSlice, nil
+}
+
+// MoveFirewallRawRule places the rule right before destination rule
+func (c Mikrotik) MoveFirewallRawRule(id, destinationID string) error {
+	return c.Move(&FirewallRawRule{Id: id}, destinationID)
+}
+
+func (c Mikrotik) DeleteFirewallRawRule(id string) error {
+	return c.Delete(&FirewallRawRule{Id: id})
+}
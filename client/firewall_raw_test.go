@@ -0,0 +1,42 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFirewallRaw_basic(t *testing.T) {
+	c := NewClient(GetConfigFromEnv())
+
+	rule := &FirewallRawRule{
+		Action:         "drop",
+		Chain:          "prerouting",
+		Comment:        "Test drop of blacklisted sources",
+		InInterface:    "ether1",
+		SrcAddressList: "blacklist",
+		Disabled:       true,
+	}
+
+	createdRule, err := c.AddFirewallRawRule(rule)
+	require.NoError(t, err)
+
+	defer func(id string) {
+		assert.NoError(t, c.DeleteFirewallRawRule(id))
+	}(createdRule.Id)
+
+	rule.Id = createdRule.Id
+
+	foundRule, err := c.FindFirewallRawRule(rule.Id)
+	require.NoError(t, err)
+	assert.Equal(t, rule, foundRule)
+
+	rule.Action = "notrack"
+	rule.SrcAddressList = ""
+	rule.DestAddress = "10.0.0.0/8"
+	updatedRule, err := c.UpdateFirewallRawRule(rule)
+	require.NoError(t, err)
+	assert.Equal(t, "notrack", updatedRule.Action)
+	assert.Equal(t, "10.0.0.0/8", updatedRule.DestAddress)
+}
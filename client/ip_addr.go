@@ -11,6 +11,7 @@ type IpAddress struct {
 	Disabled  bool   `mikrotik:"disabled" codegen:"disabled"`
 	Interface string `mikrotik:"interface" codegen:"interface,required"`
 	Network   string `mikrotik:"network" codegen:"network,computed"`
+	Vrf       string `mikrotik:"vrf" codegen:"vrf,optional,computed"`
 }
 
 var _ Resource = (*IpAddress)(nil)
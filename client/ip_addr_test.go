@@ -22,6 +22,9 @@ func TestAddIpAddressAndDeleteIpAddress(t *testing.T) {
 		Interface: ifname,
 		Network:   network,
 	}
+	if majorVersion, err := getRouterOSMajorVersion(sysResources); err == nil && majorVersion >= 7 {
+		expectedIpAddress.Vrf = "main"
+	}
 
 	ipaddr, err := c.AddIpAddress(expectedIpAddress)
 
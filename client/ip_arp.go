@@ -0,0 +1,48 @@
+package client
+
+// IpArp defines /ip/arp table entry
+type IpArp struct {
+	Id         string `mikrotik:".id"`
+	Address    string `mikrotik:"address"`
+	MacAddress string `mikrotik:"mac-address"`
+	Interface  string `mikrotik:"interface"`
+	Comment    string `mikrotik:"comment"`
+	Complete   bool   `mikrotik:"complete,readonly"`
+	Disabled   bool   `mikrotik:"disabled"`
+	Dynamic    bool   `mikrotik:"dynamic,readonly"`
+	Invalid    bool   `mikrotik:"invalid,readonly"`
+}
+
+var _ Resource = (*IpArp)(nil)
+
+func (b *IpArp) ActionToCommand(a Action) string {
+	return map[Action]string{
+		Find: "/ip/arp/print",
+		List: "/ip/arp/print",
+	}[a]
+}
+
+func (b *IpArp) IDField() string {
+	return ".id"
+}
+
+func (b *IpArp) ID() string {
+	return b.Id
+}
+
+func (b *IpArp) SetID(id string) {
+	b.Id = id
+}
+
+func (c Mikrotik) ListIpArp() ([]IpArp, error) {
+	res, err := c.List(&IpArp{})
+	if err != nil {
+		return nil, err
+	}
+	returnSlice := make([]IpArp, len(res))
+	for i, v := range res {
+		returnSlice[i] = *(v.(*IpArp))
+	}
+
+	return returnSlice, nil
+}
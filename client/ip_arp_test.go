@@ -0,0 +1,20 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListIpArp(t *testing.T) {
+	c := NewClient(GetConfigFromEnv())
+
+	entries, err := c.ListIpArp()
+	require.NoError(t, err)
+
+	for _, e := range entries {
+		assert.NotEmpty(t, e.Id)
+		assert.NotEmpty(t, e.Interface)
+	}
+}
@@ -0,0 +1,48 @@
+package client
+
+// IpNeighbor defines /ip/neighbor entry discovered via MNDP, CDP or LLDP
+type IpNeighbor struct {
+	Id         string `mikrotik:".id"`
+	Address    string `mikrotik:"address"`
+	Address6   string `mikrotik:"address6"`
+	Board      string `mikrotik:"board"`
+	Identity   string `mikrotik:"identity"`
+	Interface  string `mikrotik:"interface"`
+	MacAddress string `mikrotik:"mac-address"`
+	Platform   string `mikrotik:"platform"`
+	Version    string `mikrotik:"version"`
+}
+
+var _ Resource = (*IpNeighbor)(nil)
+
+func (b *IpNeighbor) ActionToCommand(a Action) string {
+	return map[Action]string{
+		Find: "/ip/neighbor/print",
+		List: "/ip/neighbor/print",
+	}[a]
+}
+
+func (b *IpNeighbor) IDField() string {
+	return ".id"
+}
+
+func (b *IpNeighbor) ID() string {
+	return b.Id
+}
+
+func (b *IpNeighbor) SetID(id string) {
+	b.Id = id
+}
+
+func (c Mikrotik) ListIpNeighbors() ([]IpNeighbor, error) {
+	res, err := c.List(&IpNeighbor{})
+	if err != nil {
+		return nil, err
+	}
+	returnSlice := make([]IpNeighbor, len(res))
+	for i, v := range res {
+		returnSlice[i] = *(v.(*IpNeighbor))
+	}
+
+	return returnSlice, nil
+}
@@ -0,0 +1,19 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestListIpNeighbors(t *testing.T) {
+	c := NewClient(GetConfigFromEnv())
+
+	neighbors, err := c.ListIpNeighbors()
+	require.NoError(t, err)
+
+	for _, n := range neighbors {
+		require.NotEmpty(t, n.Id)
+		require.NotEmpty(t, n.Interface)
+	}
+}
@@ -0,0 +1,88 @@
+package client
+
+import (
+	"github.com/go-routeros/routeros"
+)
+
+// IpRoute defines static route in /ip/route menu
+//
+// RouterOS v6 selects the routing table via RoutingMark and creates blackhole routes via Type,
+// while RouterOS v7 uses RoutingTable and Blackhole flag instead.
+type IpRoute struct {
+	Id           string `mikrotik:".id" codegen:"id,mikrotikID"`
+	Blackhole    bool   `mikrotik:"blackhole,omitempty" codegen:"blackhole"`
+	CheckGateway string `mikrotik:"check-gateway" codegen:"check_gateway"`
+	Comment      string `mikrotik:"comment" codegen:"comment"`
+	Disabled     bool   `mikrotik:"disabled" codegen:"disabled"`
+	Distance     int    `mikrotik:"distance" codegen:"distance"`
+	DstAddress   string `mikrotik:"dst-address" codegen:"dst_address,required"`
+	Dynamic      bool   `mikrotik:"dynamic,readonly" codegen:"dynamic,computed"`
+	Gateway      string `mikrotik:"gateway" codegen:"gateway"`
+	PrefSrc      string `mikrotik:"pref-src" codegen:"pref_src"`
+	RoutingMark  string `mikrotik:"routing-mark" codegen:"routing_mark"`
+	RoutingTable string `mikrotik:"routing-table" codegen:"routing_table"`
+	Scope        int    `mikrotik:"scope" codegen:"scope"`
+	TargetScope  int    `mikrotik:"target-scope" codegen:"target_scope"`
+	Type         string `mikrotik:"type" codegen:"type"`
+	VrfInterface string `mikrotik:"vrf-interface" codegen:"vrf_interface"`
+}
+
+var _ Resource = (*IpRoute)(nil)
+
+func (b *IpRoute) ActionToCommand(a Action) string {
+	return map[Action]string{
+		Add:    "/ip/route/add",
+		Find:   "/ip/route/print",
+		List:   "/ip/route/print",
+		Update: "/ip/route/set",
+		Delete: "/ip/route/remove",
+	}[a]
+}
+
+func (b *IpRoute) IDField() string {
+	return ".id"
+}
+
+func (b *IpRoute) ID() string {
+	return b.Id
+}
+
+func (b *IpRoute) SetID(id string) {
+	b.Id = id
+}
+
+func (b *IpRoute) AfterAddHook(r *routeros.Reply) {
+	b.Id = r.Done.Map["ret"]
+}
+
+// Typed wrappers
+func (c Mikrotik) AddIpRoute(r *IpRoute) (*IpRoute, error) {
+	res, err := c.Add(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*IpRoute), nil
+}
+
+func (c Mikrotik) UpdateIpRoute(r *IpRoute) (*IpRoute, error) {
+	res, err := c.Update(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*IpRoute), nil
+}
+
+func (c Mikrotik) FindIpRoute(id string) (*IpRoute, error) {
+	res, err := c.Find(&IpRoute{Id: id})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*IpRoute), nil
+}
+
+func (c Mikrotik) DeleteIpRoute(id string) error {
+	return c.Delete(&IpRoute{Id: id})
+}
@@ -0,0 +1,60 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIpRoute_basic(t *testing.T) {
+	c := NewClient(GetConfigFromEnv())
+
+	route := &IpRoute{
+		Comment:    "Test route",
+		Distance:   5,
+		DstAddress: "10.77.0.0/24",
+		Gateway:    "192.168.88.254",
+		Disabled:   true,
+	}
+
+	created, err := c.AddIpRoute(route)
+	require.NoError(t, err)
+
+	defer func(id string) {
+		assert.NoError(t, c.DeleteIpRoute(id))
+	}(created.Id)
+
+	found, err := c.FindIpRoute(created.Id)
+	require.NoError(t, err)
+	assert.Equal(t, route.DstAddress, found.DstAddress)
+	assert.Equal(t, route.Gateway, found.Gateway)
+	assert.Equal(t, route.Distance, found.Distance)
+	assert.Equal(t, route.Comment, found.Comment)
+
+	found.Distance = 10
+	updated, err := c.UpdateIpRoute(found)
+	require.NoError(t, err)
+	assert.Equal(t, 10, updated.Distance)
+}
+
+func TestIpRoute_blackhole(t *testing.T) {
+	SkipIfRouterOSV6OrEarlier(t, sysResources)
+	c := NewClient(GetConfigFromEnv())
+
+	created, err := c.AddIpRoute(&IpRoute{
+		Blackhole:  true,
+		DstAddress: "10.77.1.0/24",
+		Disabled:   true,
+	})
+	require.NoError(t, err)
+
+	defer func(id string) {
+		assert.NoError(t, c.DeleteIpRoute(id))
+	}(created.Id)
+
+	found, err := c.FindIpRoute(created.Id)
+	require.NoError(t, err)
+	assert.True(t, found.Blackhole)
+	assert.Equal(t, "main", found.RoutingTable)
+}
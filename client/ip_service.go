@@ -0,0 +1,135 @@
+package client
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client/types"
+)
+
+// IpService defines management service in /ip/service menu
+//
+// Services are built into RouterOS, so they cannot be added or removed, only configured.
+type IpService struct {
+	Id          string             `mikrotik:".id" codegen:"id,mikrotikID"`
+	Name        string             `mikrotik:"name,readonly" codegen:"name,terraformID,required"`
+	Address     types.MikrotikList `mikrotik:"address,clearable" codegen:"address"`
+	Certificate string             `mikrotik:"certificate" codegen:"certificate"`
+	Disabled    bool               `mikrotik:"disabled" codegen:"disabled"`
+	Port        int                `mikrotik:"port" codegen:"port"`
+	TlsVersion  string             `mikrotik:"tls-version" codegen:"tls_version"`
+}
+
+// ipServiceDefaultPorts holds factory ports of the services.
+//
+// The set of services and their ports are the same in RouterOS v6 and v7,
+// so the map must be extended if a later RouterOS version adds a service.
+var ipServiceDefaultPorts = map[string]int{
+	"api":     8728,
+	"api-ssl": 8729,
+	"ftp":     21,
+	"ssh":     22,
+	"telnet":  23,
+	"winbox":  8291,
+	"www":     80,
+	"www-ssl": 443,
+}
+
+var _ Resource = (*IpService)(nil)
+
+func (b *IpService) ActionToCommand(a Action) string {
+	return map[Action]string{
+		Find:   "/ip/service/print",
+		Update: "/ip/service/set",
+	}[a]
+}
+
+func (b *IpService) IDField() string {
+	return ".id"
+}
+
+func (b *IpService) ID() string {
+	return b.Id
+}
+
+func (b *IpService) SetID(id string) {
+	b.Id = id
+}
+
+func (b *IpService) FindField() string {
+	return "name"
+}
+
+func (b *IpService) FindFieldValue() string {
+	return b.Name
+}
+
+// Typed wrappers
+func (c Mikrotik) UpdateIpService(r *IpService) (*IpService, error) {
+	res, err := c.Update(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*IpService), nil
+}
+
+func (c Mikrotik) FindIpService(name string) (*IpService, error) {
+	res, err := c.Find(&IpService{Name: name})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*IpService), nil
+}
+
+// ResetIpService restores factory settings of the service.
+//
+// RouterOS cannot unset the port of a service, so the factory settings are hard-coded,
+// as found in RouterOS v6 and v7: only www-ssl service is disabled by default,
+// certificate and TLS version are reset only for services supporting them.
+// Services unknown to ipServiceDefaultPorts are not changed and an error is returned.
+func (c Mikrotik) ResetIpService(name string) error {
+	port, ok := ipServiceDefaultPorts[name]
+	if !ok {
+		return fmt.Errorf("unknown service %q, factory settings are known only for %s", name, strings.Join(ipServiceNames(), ", "))
+	}
+
+	client, err := c.getMikrotikClient()
+	if err != nil {
+		return err
+	}
+
+	disabled := "no"
+	if name == "www-ssl" {
+		disabled = "yes"
+	}
+	cmd := []string{
+		"/ip/service/set",
+		"=numbers=" + name,
+		"=address=",
+		"=disabled=" + disabled,
+		"=port=" + strconv.Itoa(port),
+	}
+	if name == "api-ssl" || name == "www-ssl" {
+		cmd = append(cmd, "=certificate=none", "=tls-version=any")
+	}
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	_, err = client.RunArgs(cmd)
+
+	return err
+}
+
+// ipServiceNames returns sorted names of the services with known factory settings.
+func ipServiceNames() []string {
+	names := make([]string, 0, len(ipServiceDefaultPorts))
+	for name := range ipServiceDefaultPorts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
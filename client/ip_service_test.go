@@ -0,0 +1,54 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIpService_basic(t *testing.T) {
+	c := NewClient(GetConfigFromEnv())
+
+	service, err := c.FindIpService("telnet")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, c.ResetIpService("telnet"))
+
+		found, err := c.FindIpService("telnet")
+		require.NoError(t, err)
+		assert.False(t, found.Disabled)
+		assert.Equal(t, 23, found.Port)
+		assert.Empty(t, found.Address)
+	}()
+
+	service.Address = types.MikrotikList{"192.168.88.0/24"}
+	service.Disabled = true
+	service.Port = 2323
+	updated, err := c.UpdateIpService(service)
+	require.NoError(t, err)
+	assert.Equal(t, service, updated)
+}
+
+func TestResetIpService_unknownService(t *testing.T) {
+	c := NewClient(GetConfigFromEnv())
+
+	err := c.ResetIpService("gopher")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unknown service "gopher"`)
+	assert.Contains(t, err.Error(), "api, api-ssl, ftp, ssh, telnet, winbox, www, www-ssl")
+}
+
+func TestResetIpService_knownServices(t *testing.T) {
+	c := NewClient(GetConfigFromEnv())
+
+	// every service of the tested RouterOS version must have known factory settings
+	services, err := c.List(&IpService{})
+	require.NoError(t, err)
+	require.NotEmpty(t, services)
+	for _, s := range services {
+		name := s.(*IpService).Name
+		assert.Contains(t, ipServiceNames(), name, "factory settings of service %q are not known", name)
+	}
+}
@@ -0,0 +1,106 @@
+package client
+
+import (
+	"github.com/ddelnano/terraform-provider-mikrotik/client/types"
+	"github.com/go-routeros/routeros"
+)
+
+// Ipv6FirewallAddressList defines /ipv6/firewall/address-list entry
+type Ipv6FirewallAddressList struct {
+	Id           string                 `mikrotik:".id" codegen:"id,mikrotikID,terraformID"`
+	Address      string                 `mikrotik:"address" codegen:"address,required"`
+	Comment      string                 `mikrotik:"comment" codegen:"comment"`
+	CreationTime string                 `mikrotik:"creation-time,readonly" codegen:"creation_time,computed"`
+	Disabled     bool                   `mikrotik:"disabled" codegen:"disabled"`
+	Dynamic      bool                   `mikrotik:"dynamic,readonly" codegen:"dynamic,computed"`
+	List         string                 `mikrotik:"list" codegen:"list,required"`
+	Timeout      types.MikrotikDuration `mikrotik:"timeout" codegen:"timeout"`
+}
+
+var _ Resource = (*Ipv6FirewallAddressList)(nil)
+
+func (b *Ipv6FirewallAddressList) ActionToCommand(a Action) string {
+	return map[Action]string{
+		Add:    "/ipv6/firewall/address-list/add",
+		Find:   "/ipv6/firewall/address-list/print",
+		List:   "/ipv6/firewall/address-list/print",
+		Update: "/ipv6/firewall/address-list/set",
+		Delete: "/ipv6/firewall/address-list/remove",
+	}[a]
+}
+
+func (b *Ipv6FirewallAddressList) IDField() string {
+	return ".id"
+}
+
+func (b *Ipv6FirewallAddressList) ID() string {
+	return b.Id
+}
+
+func (b *Ipv6FirewallAddressList) SetID(id string) {
+	b.Id = id
+}
+
+func (b *Ipv6FirewallAddressList) AfterAddHook(r *routeros.Reply) {
+	b.Id = r.Done.Map["ret"]
+}
+
+func (c Mikrotik) AddIpv6FirewallAddressList(r *Ipv6FirewallAddressList) (*Ipv6FirewallAddressList, error) {
+	res, err := c.Add(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*Ipv6FirewallAddressList), nil
+}
+
+func (c Mikrotik) UpdateIpv6FirewallAddressList(r *Ipv6FirewallAddressList) (*Ipv6FirewallAddressList, error) {
+	res, err := c.Update(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*Ipv6FirewallAddressList), nil
+}
+
+func (c Mikrotik) FindIpv6FirewallAddressList(id string) (*Ipv6FirewallAddressList, error) {
+	res, err := c.Find(&Ipv6FirewallAddressList{Id: id})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*Ipv6FirewallAddressList), nil
+}
+
+func (c Mikrotik) DeleteIpv6FirewallAddressList(id string) error {
+	return c.Delete(&Ipv6FirewallAddressList{Id: id})
+}
+
+// ListIpv6FirewallAddressListEntries retrieves all entries of the named address list using single request
+func (c Mikrotik) ListIpv6FirewallAddressListEntries(list string) ([]Ipv6FirewallAddressList, error) {
+	res, err := c.ListByField(&Ipv6FirewallAddressList{}, "list", list)
+	if err != nil {
+		return nil, err
+	}
+	returnSlice := make([]Ipv6FirewallAddressList, len(res))
+	for i, v := range res {
+		returnSlice[i] = *(v.(*Ipv6FirewallAddressList))
+	}
+
+	return returnSlice, nil
+}
+
+// AddIpv6FirewallAddressListEntries creates multiple address list entries in a batch
+func (c Mikrotik) AddIpv6FirewallAddressListEntries(entries []Ipv6FirewallAddressList) error {
+	items := make([]Resource, len(entries))
+	for i := range entries {
+		items[i] = &entries[i]
+	}
+
+	return c.AddMany(items)
+}
+
+// DeleteIpv6FirewallAddressListEntries removes multiple address list entries in a batch
+func (c Mikrotik) DeleteIpv6FirewallAddressListEntries(ids []string) error {
+	return c.DeleteMany(&Ipv6FirewallAddressList{}, ids)
+}
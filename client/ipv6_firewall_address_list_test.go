@@ -0,0 +1,36 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIpv6FirewallAddressList_basic(t *testing.T) {
+	c := NewClient(GetConfigFromEnv())
+
+	entry := &Ipv6FirewallAddressList{
+		List:    "testacc-list",
+		Address: "2001:db8:10::/48",
+		Comment: "Test entry",
+	}
+
+	created, err := c.AddIpv6FirewallAddressList(entry)
+	require.NoError(t, err)
+
+	defer func(id string) {
+		assert.NoError(t, c.DeleteIpv6FirewallAddressList(id))
+	}(created.Id)
+
+	entry.Id = created.Id
+	entry.CreationTime = created.CreationTime
+
+	found, err := c.FindIpv6FirewallAddressList(entry.Id)
+	require.NoError(t, err)
+	assert.Equal(t, entry, found)
+
+	entries, err := c.ListIpv6FirewallAddressListEntries(entry.List)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
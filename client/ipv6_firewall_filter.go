@@ -0,0 +1,118 @@
+package client
+
+import (
+	"github.com/ddelnano/terraform-provider-mikrotik/client/types"
+	"github.com/go-routeros/routeros"
+)
+
+// Ipv6FirewallFilterRule defines /ipv6/firewall/filter rule
+type Ipv6FirewallFilterRule struct {
+	Id                       string             `mikrotik:".id" codegen:"id,mikrotikID,terraformID"`
+	Action                   string             `mikrotik:"action" codegen:"action"`
+	Chain                    string             `mikrotik:"chain" codegen:"chain,required"`
+	Comment                  string             `mikrotik:"comment" codegen:"comment"`
+	ConnectionMark           string             `mikrotik:"connection-mark" codegen:"connection_mark"`
+	ConnectionNatState       types.MikrotikList `mikrotik:"connection-nat-state" codegen:"connection_nat_state"`
+	ConnectionNatStateNegate bool               `mikrotik:"connection-nat-state,negate" codegen:"connection_nat_state_negate"`
+	ConnectionState          types.MikrotikList `mikrotik:"connection-state" codegen:"connection_state"`
+	ConnectionStateNegate    bool               `mikrotik:"connection-state,negate" codegen:"connection_state_negate"`
+	DestAddress              string             `mikrotik:"dst-address" codegen:"dst_address"`
+	DestAddressList          string             `mikrotik:"dst-address-list" codegen:"dst_address_list"`
+	DestPort                 string             `mikrotik:"dst-port" codegen:"dst_port"`
+	Disabled                 bool               `mikrotik:"disabled" codegen:"disabled"`
+	Dynamic                  bool               `mikrotik:"dynamic,readonly" codegen:"dynamic,computed"`
+	IcmpOptions              string             `mikrotik:"icmp-options" codegen:"icmp_options"`
+	InInterface              string             `mikrotik:"in-interface" codegen:"in_interface"`
+	InInterfaceList          string             `mikrotik:"in-interface-list" codegen:"in_interface_list"`
+	JumpTarget               string             `mikrotik:"jump-target" codegen:"jump_target"`
+	Limit                    string             `mikrotik:"limit" codegen:"limit"`
+	Log                      bool               `mikrotik:"log" codegen:"log"`
+	LogPrefix                string             `mikrotik:"log-prefix" codegen:"log_prefix"`
+	OutInterface             string             `mikrotik:"out-interface" codegen:"out_interface"`
+	OutInterfaceList         string             `mikrotik:"out-interface-list" codegen:"out_interface_list"`
+	PacketMark               string             `mikrotik:"packet-mark" codegen:"packet_mark"`
+	Protocol                 string             `mikrotik:"protocol" codegen:"protocol"`
+	RejectWith               string             `mikrotik:"reject-with" codegen:"reject_with"`
+	SrcAddress               string             `mikrotik:"src-address" codegen:"src_address"`
+	SrcAddressList           string             `mikrotik:"src-address-list" codegen:"src_address_list"`
+	SrcPort                  string             `mikrotik:"src-port" codegen:"src_port"`
+	TcpFlags                 types.MikrotikList `mikrotik:"tcp-flags" codegen:"tcp_flags"`
+}
+
+var _ Resource = (*Ipv6FirewallFilterRule)(nil)
+
+func (b *Ipv6FirewallFilterRule) ActionToCommand(a Action) string {
+	return map[Action]string{
+		Add:    "/ipv6/firewall/filter/add",
+		Find:   "/ipv6/firewall/filter/print",
+		List:   "/ipv6/firewall/filter/print",
+		Update: "/ipv6/firewall/filter/set",
+		Delete: "/ipv6/firewall/filter/remove",
+		Move:   "/ipv6/firewall/filter/move",
+	}[a]
+}
+
+func (b *Ipv6FirewallFilterRule) IDField() string {
+	return ".id"
+}
+
+func (b *Ipv6FirewallFilterRule) ID() string {
+	return b.Id
+}
+
+func (b *Ipv6FirewallFilterRule) SetID(id string) {
+	b.Id = id
+}
+
+func (b *Ipv6FirewallFilterRule) AfterAddHook(r *routeros.Reply) {
+	b.Id = r.Done.Map["ret"]
+}
+
+func (c Mikrotik) AddIpv6FirewallFilterRule(r *Ipv6FirewallFilterRule) (*Ipv6FirewallFilterRule, error) {
+	res, err := c.Add(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*Ipv6FirewallFilterRule), nil
+}
+
+func (c Mikrotik) UpdateIpv6FirewallFilterRule(r *Ipv6FirewallFilterRule) (*Ipv6FirewallFilterRule, error) {
+	res, err := c.Update(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*Ipv6FirewallFilterRule), nil
+}
+
+func (c Mikrotik) FindIpv6FirewallFilterRule(id string) (*Ipv6FirewallFilterRule, error) {
+	res, err := c.Find(&Ipv6FirewallFilterRule{Id: id})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*Ipv6FirewallFilterRule), nil
+}
+
+func (c Mikrotik) ListIpv6FirewallFilterRules() ([]Ipv6FirewallFilterRule, error) {
+	res, err := c.List(&Ipv6FirewallFilterRule{})
+	if err != nil {
+		return nil, err
+	}
+	returnSlice := make([]Ipv6FirewallFilterRule, len(res))
+	for i, v := range res {
+		returnSlice[i] = *(v.(*Ipv6FirewallFilterRule))
+	}
+
+	return returnSlice, nil
+}
+
+// MoveIpv6FirewallFilterRule places the rule right before destination rule
+func (c Mikrotik) MoveIpv6FirewallFilterRule(id, destinationID string) error {
+	return c.Move(&Ipv6FirewallFilterRule{Id: id}, destinationID)
+}
+
+func (c Mikrotik) DeleteIpv6FirewallFilterRule(id string) error {
+	return c.Delete(&Ipv6FirewallFilterRule{Id: id})
+}
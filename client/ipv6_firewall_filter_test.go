@@ -0,0 +1,69 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIpv6FirewallFilter_basic(t *testing.T) {
+	c := NewClient(GetConfigFromEnv())
+
+	rule := &Ipv6FirewallFilterRule{
+		Action:          "accept",
+		Chain:           "input",
+		Comment:         "Test IPv6 rule",
+		ConnectionState: types.MikrotikList{"new"},
+		DestPort:        "22",
+		Protocol:        "tcp",
+		SrcAddress:      "2001:db8::/32",
+		Disabled:        true,
+	}
+
+	createdRule, err := c.AddIpv6FirewallFilterRule(rule)
+	require.NoError(t, err)
+
+	defer func(id string) {
+		assert.NoError(t, c.DeleteIpv6FirewallFilterRule(id))
+	}(createdRule.Id)
+
+	rule.Id = createdRule.Id
+
+	foundRule, err := c.FindIpv6FirewallFilterRule(rule.Id)
+	require.NoError(t, err)
+	assert.Equal(t, rule, foundRule)
+
+	rule.Protocol = "udp"
+	updatedRule, err := c.UpdateIpv6FirewallFilterRule(rule)
+	require.NoError(t, err)
+	assert.Equal(t, rule, updatedRule)
+}
+
+func TestIpv6FirewallFilter_move(t *testing.T) {
+	c := NewClient(GetConfigFromEnv())
+
+	first, err := c.AddIpv6FirewallFilterRule(&Ipv6FirewallFilterRule{Chain: "mychain", Comment: "first", Disabled: true})
+	require.NoError(t, err)
+	defer func(id string) {
+		assert.NoError(t, c.DeleteIpv6FirewallFilterRule(id))
+	}(first.Id)
+
+	second, err := c.AddIpv6FirewallFilterRule(&Ipv6FirewallFilterRule{Chain: "mychain", Comment: "second", Disabled: true})
+	require.NoError(t, err)
+	defer func(id string) {
+		assert.NoError(t, c.DeleteIpv6FirewallFilterRule(id))
+	}(second.Id)
+
+	require.NoError(t, c.MoveIpv6FirewallFilterRule(second.Id, first.Id))
+
+	rules, err := c.ListIpv6FirewallFilterRules()
+	require.NoError(t, err)
+
+	positions := map[string]int{}
+	for i, r := range rules {
+		positions[r.Id] = i
+	}
+	assert.Less(t, positions[second.Id], positions[first.Id])
+}
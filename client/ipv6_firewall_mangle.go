@@ -0,0 +1,128 @@
+package client
+
+import (
+	"github.com/ddelnano/terraform-provider-mikrotik/client/types"
+	"github.com/go-routeros/routeros"
+)
+
+// Ipv6FirewallMangleRule defines /ipv6/firewall/mangle rule
+type Ipv6FirewallMangleRule struct {
+	Id                       string             `mikrotik:".id" codegen:"id,mikrotikID,terraformID"`
+	Action                   string             `mikrotik:"action" codegen:"action"`
+	Chain                    string             `mikrotik:"chain" codegen:"chain,required"`
+	Comment                  string             `mikrotik:"comment" codegen:"comment"`
+	ConnectionMark           string             `mikrotik:"connection-mark" codegen:"connection_mark"`
+	ConnectionNatState       types.MikrotikList `mikrotik:"connection-nat-state" codegen:"connection_nat_state"`
+	ConnectionNatStateNegate bool               `mikrotik:"connection-nat-state,negate" codegen:"connection_nat_state_negate"`
+	ConnectionState          types.MikrotikList `mikrotik:"connection-state" codegen:"connection_state"`
+	ConnectionStateNegate    bool               `mikrotik:"connection-state,negate" codegen:"connection_state_negate"`
+	DestAddress              string             `mikrotik:"dst-address" codegen:"dst_address"`
+	DestAddressList          string             `mikrotik:"dst-address-list" codegen:"dst_address_list"`
+	DestPort                 string             `mikrotik:"dst-port" codegen:"dst_port"`
+	Disabled                 bool               `mikrotik:"disabled" codegen:"disabled"`
+	Dynamic                  bool               `mikrotik:"dynamic,readonly" codegen:"dynamic,computed"`
+	IcmpOptions              string             `mikrotik:"icmp-options" codegen:"icmp_options"`
+	InInterface              string             `mikrotik:"in-interface" codegen:"in_interface"`
+	InInterfaceList          string             `mikrotik:"in-interface-list" codegen:"in_interface_list"`
+	JumpTarget               string             `mikrotik:"jump-target" codegen:"jump_target"`
+	Limit                    string             `mikrotik:"limit" codegen:"limit"`
+	Log                      bool               `mikrotik:"log" codegen:"log"`
+	LogPrefix                string             `mikrotik:"log-prefix" codegen:"log_prefix"`
+	NewConnectionMark        string             `mikrotik:"new-connection-mark" codegen:"new_connection_mark"`
+	NewMss                   string             `mikrotik:"new-mss" codegen:"new_mss"`
+	NewPacketMark            string             `mikrotik:"new-packet-mark" codegen:"new_packet_mark"`
+	NewRoutingMark           string             `mikrotik:"new-routing-mark" codegen:"new_routing_mark"`
+	OutInterface             string             `mikrotik:"out-interface" codegen:"out_interface"`
+	OutInterfaceList         string             `mikrotik:"out-interface-list" codegen:"out_interface_list"`
+	PacketMark               string             `mikrotik:"packet-mark" codegen:"packet_mark"`
+	Passthrough              bool               `mikrotik:"passthrough" codegen:"passthrough"`
+	Protocol                 string             `mikrotik:"protocol" codegen:"protocol"`
+	RoutingMark              string             `mikrotik:"routing-mark" codegen:"routing_mark"`
+	SrcAddress               string             `mikrotik:"src-address" codegen:"src_address"`
+	SrcAddressList           string             `mikrotik:"src-address-list" codegen:"src_address_list"`
+	SrcPort                  string             `mikrotik:"src-port" codegen:"src_port"`
+	TcpFlags                 types.MikrotikList `mikrotik:"tcp-flags" codegen:"tcp_flags"`
+}
+
+var _ Resource = (*Ipv6FirewallMangleRule)(nil)
+
+func (b *Ipv6FirewallMangleRule) ActionToCommand(a Action) string {
+	return map[Action]string{
+		Add:    "/ipv6/firewall/mangle/add",
+		Find:   "/ipv6/firewall/mangle/print",
+		List:   "/ipv6/firewall/mangle/print",
+		Update: "/ipv6/firewall/mangle/set",
+		Delete: "/ipv6/firewall/mangle/remove",
+		Move:   "/ipv6/firewall/mangle/move",
+	}[a]
+}
+
+func (b *Ipv6FirewallMangleRule) IDField() string {
+	return ".id"
+}
+
+func (b *Ipv6FirewallMangleRule) ID() string {
+	return b.Id
+}
+
+func (b *Ipv6FirewallMangleRule) SetID(id string) {
+	b.Id = id
+}
+
+func (b *Ipv6FirewallMangleRule) AfterAddHook(r *routeros.Reply) {
+	b.Id = r.Done.Map["ret"]
+}
+
+// OmitMikrotikProperty skips `passthrough` for actions which do not use it.
+func (b *Ipv6FirewallMangleRule) OmitMikrotikProperty(name string) bool {
+	return name == "passthrough" && !MangleActionUsesPassthrough(b.Action)
+}
+
+func (c Mikrotik) AddIpv6FirewallMangleRule(r *Ipv6FirewallMangleRule) (*Ipv6FirewallMangleRule, error) {
+	res, err := c.Add(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*Ipv6FirewallMangleRule), nil
+}
+
+func (c Mikrotik) UpdateIpv6FirewallMangleRule(r *Ipv6FirewallMangleRule) (*Ipv6FirewallMangleRule, error) {
+	res, err := c.Update(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*Ipv6FirewallMangleRule), nil
+}
+
+func (c Mikrotik) FindIpv6FirewallMangleRule(id string) (*Ipv6FirewallMangleRule, error) {
+	res, err := c.Find(&Ipv6FirewallMangleRule{Id: id})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*Ipv6FirewallMangleRule), nil
+}
+
+func (c Mikrotik) ListIpv6FirewallMangleRules() ([]Ipv6FirewallMangleRule, error) {
+	res, err := c.List(&Ipv6FirewallMangleRule{})
+	if err != nil {
+		return nil, err
+	}
+	returnSlice := make([]Ipv6FirewallMangleRule, len(res))
+	for i, v := range res {
+		returnSlice[i] = *(v.(*Ipv6FirewallMangleRule))
+	}
+
+	return returnSlice, nil
+}
+
+// MoveIpv6FirewallMangleRule places the rule right before destination rule
+func (c Mikrotik) MoveIpv6FirewallMangleRule(id, destinationID string) error {
+	return c.Move(&Ipv6FirewallMangleRule{Id: id}, destinationID)
+}
+
+func (c Mikrotik) DeleteIpv6FirewallMangleRule(id string) error {
+	return c.Delete(&Ipv6FirewallMangleRule{Id: id})
+}
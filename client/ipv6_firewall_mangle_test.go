@@ -0,0 +1,37 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIpv6FirewallMangle_markConnection(t *testing.T) {
+	c := NewClient(GetConfigFromEnv())
+
+	rule := &Ipv6FirewallMangleRule{
+		Action:            "mark-connection",
+		Chain:             "prerouting",
+		Comment:           "Test IPv6 mangle rule",
+		ConnectionState:   types.MikrotikList{"new"},
+		NewConnectionMark: "testacc",
+		SrcAddress:        "2001:db8::/32",
+		Passthrough:       true,
+		Disabled:          true,
+	}
+
+	createdRule, err := c.AddIpv6FirewallMangleRule(rule)
+	require.NoError(t, err)
+
+	defer func(id string) {
+		assert.NoError(t, c.DeleteIpv6FirewallMangleRule(id))
+	}(createdRule.Id)
+
+	rule.Id = createdRule.Id
+
+	foundRule, err := c.FindIpv6FirewallMangleRule(rule.Id)
+	require.NoError(t, err)
+	assert.Equal(t, rule, foundRule)
+}
@@ -0,0 +1,37 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIpv6FirewallNat_basic(t *testing.T) {
+	SkipIfRouterOSV6OrEarlier(t, sysResources)
+	c := NewClient(GetConfigFromEnv())
+
+	rule := &Ipv6FirewallNatRule{
+		Action:    "dst-nat",
+		Chain:     "dstnat",
+		Comment:   "Test IPv6 port forward",
+		DestPort:  "8080",
+		Protocol:  "tcp",
+		ToAddress: "fd00::10/128",
+		ToPorts:   "80",
+		Disabled:  true,
+	}
+
+	createdRule, err := c.AddIpv6FirewallNatRule(rule)
+	require.NoError(t, err)
+
+	defer func(id string) {
+		assert.NoError(t, c.DeleteIpv6FirewallNatRule(id))
+	}(createdRule.Id)
+
+	rule.Id = createdRule.Id
+
+	foundRule, err := c.FindIpv6FirewallNatRule(rule.Id)
+	require.NoError(t, err)
+	assert.Equal(t, rule, foundRule)
+}
@@ -0,0 +1,86 @@
+package client
+
+import (
+	"github.com/go-routeros/routeros"
+)
+
+// Ipv6Route defines static route in /ipv6/route menu
+//
+// RouterOS v6 creates unreachable routes via Type,
+// while RouterOS v7 uses Blackhole flag and supports RoutingTable and VrfInterface.
+type Ipv6Route struct {
+	Id           string `mikrotik:".id" codegen:"id,mikrotikID"`
+	Blackhole    bool   `mikrotik:"blackhole,omitempty" codegen:"blackhole"`
+	CheckGateway string `mikrotik:"check-gateway" codegen:"check_gateway"`
+	Comment      string `mikrotik:"comment" codegen:"comment"`
+	Disabled     bool   `mikrotik:"disabled" codegen:"disabled"`
+	Distance     int    `mikrotik:"distance" codegen:"distance"`
+	DstAddress   string `mikrotik:"dst-address" codegen:"dst_address,required"`
+	Dynamic      bool   `mikrotik:"dynamic,readonly" codegen:"dynamic,computed"`
+	Gateway      string `mikrotik:"gateway" codegen:"gateway"`
+	RoutingTable string `mikrotik:"routing-table" codegen:"routing_table"`
+	Scope        int    `mikrotik:"scope" codegen:"scope"`
+	TargetScope  int    `mikrotik:"target-scope" codegen:"target_scope"`
+	Type         string `mikrotik:"type" codegen:"type"`
+	VrfInterface string `mikrotik:"vrf-interface" codegen:"vrf_interface"`
+}
+
+var _ Resource = (*Ipv6Route)(nil)
+
+func (b *Ipv6Route) ActionToCommand(a Action) string {
+	return map[Action]string{
+		Add:    "/ipv6/route/add",
+		Find:   "/ipv6/route/print",
+		List:   "/ipv6/route/print",
+		Update: "/ipv6/route/set",
+		Delete: "/ipv6/route/remove",
+	}[a]
+}
+
+func (b *Ipv6Route) IDField() string {
+	return ".id"
+}
+
+func (b *Ipv6Route) ID() string {
+	return b.Id
+}
+
+func (b *Ipv6Route) SetID(id string) {
+	b.Id = id
+}
+
+func (b *Ipv6Route) AfterAddHook(r *routeros.Reply) {
+	b.Id = r.Done.Map["ret"]
+}
+
+// Typed wrappers
+func (c Mikrotik) AddIpv6Route(r *Ipv6Route) (*Ipv6Route, error) {
+	res, err := c.Add(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*Ipv6Route), nil
+}
+
+func (c Mikrotik) UpdateIpv6Route(r *Ipv6Route) (*Ipv6Route, error) {
+	res, err := c.Update(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*Ipv6Route), nil
+}
+
+func (c Mikrotik) FindIpv6Route(id string) (*Ipv6Route, error) {
+	res, err := c.Find(&Ipv6Route{Id: id})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*Ipv6Route), nil
+}
+
+func (c Mikrotik) DeleteIpv6Route(id string) error {
+	return c.Delete(&Ipv6Route{Id: id})
+}
@@ -0,0 +1,34 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIpv6Route_basic(t *testing.T) {
+	c := NewClient(GetConfigFromEnv())
+
+	route := &Ipv6Route{
+		Comment:    "Test IPv6 route",
+		Distance:   5,
+		DstAddress: "2001:db8:77::/48",
+		Gateway:    "fe80::1%ether1",
+		Disabled:   true,
+	}
+
+	created, err := c.AddIpv6Route(route)
+	require.NoError(t, err)
+
+	defer func(id string) {
+		assert.NoError(t, c.DeleteIpv6Route(id))
+	}(created.Id)
+
+	found, err := c.FindIpv6Route(created.Id)
+	require.NoError(t, err)
+	assert.Equal(t, route.DstAddress, found.DstAddress)
+	assert.Equal(t, route.Gateway, found.Gateway)
+	assert.Equal(t, route.Distance, found.Distance)
+	assert.Equal(t, route.Comment, found.Comment)
+}
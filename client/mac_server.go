@@ -0,0 +1,46 @@
+package client
+
+// MacServer defines /tool/mac-server settings
+type MacServer struct {
+	AllowedInterfaceList string `mikrotik:"allowed-interface-list"`
+}
+
+var (
+	_ Singleton = (*MacServer)(nil)
+	_ Resetter  = (*MacServer)(nil)
+)
+
+func (m *MacServer) ActionToCommand(action Action) string {
+	return map[Action]string{
+		Find:   "/tool/mac-server/print",
+		Update: "/tool/mac-server/set",
+	}[action]
+}
+
+func (m *MacServer) DefaultValues() map[string]string {
+	return map[string]string{
+		"allowed-interface-list": "all",
+	}
+}
+
+func (client Mikrotik) FindMacServer() (*MacServer, error) {
+	res, err := client.FindSingleton(&MacServer{})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*MacServer), nil
+}
+
+func (client Mikrotik) UpdateMacServer(m *MacServer) (*MacServer, error) {
+	res, err := client.UpdateSingleton(m)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*MacServer), nil
+}
+
+func (client Mikrotik) ResetMacServer() error {
+	return client.ResetSingleton(&MacServer{})
+}
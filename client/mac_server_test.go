@@ -0,0 +1,26 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMacServer_updateAndReset(t *testing.T) {
+	c := NewClient(GetConfigFromEnv())
+
+	defer func() {
+		require.NoError(t, c.ResetMacServer())
+
+		found, err := c.FindMacServer()
+		require.NoError(t, err)
+		assert.Equal(t, "all", found.AllowedInterfaceList)
+	}()
+
+	updated, err := c.UpdateMacServer(&MacServer{
+		AllowedInterfaceList: "none",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "none", updated.AllowedInterfaceList)
+}
@@ -0,0 +1,46 @@
+package client
+
+// MacWinboxServer defines /tool/mac-server/mac-winbox settings
+type MacWinboxServer struct {
+	AllowedInterfaceList string `mikrotik:"allowed-interface-list"`
+}
+
+var (
+	_ Singleton = (*MacWinboxServer)(nil)
+	_ Resetter  = (*MacWinboxServer)(nil)
+)
+
+func (m *MacWinboxServer) ActionToCommand(action Action) string {
+	return map[Action]string{
+		Find:   "/tool/mac-server/mac-winbox/print",
+		Update: "/tool/mac-server/mac-winbox/set",
+	}[action]
+}
+
+func (m *MacWinboxServer) DefaultValues() map[string]string {
+	return map[string]string{
+		"allowed-interface-list": "all",
+	}
+}
+
+func (client Mikrotik) FindMacWinboxServer() (*MacWinboxServer, error) {
+	res, err := client.FindSingleton(&MacWinboxServer{})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*MacWinboxServer), nil
+}
+
+func (client Mikrotik) UpdateMacWinboxServer(m *MacWinboxServer) (*MacWinboxServer, error) {
+	res, err := client.UpdateSingleton(m)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*MacWinboxServer), nil
+}
+
+func (client Mikrotik) ResetMacWinboxServer() error {
+	return client.ResetSingleton(&MacWinboxServer{})
+}
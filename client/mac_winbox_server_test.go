@@ -0,0 +1,26 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMacWinboxServer_updateAndReset(t *testing.T) {
+	c := NewClient(GetConfigFromEnv())
+
+	defer func() {
+		require.NoError(t, c.ResetMacWinboxServer())
+
+		found, err := c.FindMacWinboxServer()
+		require.NoError(t, err)
+		assert.Equal(t, "all", found.AllowedInterfaceList)
+	}()
+
+	updated, err := c.UpdateMacWinboxServer(&MacWinboxServer{
+		AllowedInterfaceList: "none",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "none", updated.AllowedInterfaceList)
+}
@@ -0,0 +1,53 @@
+package client
+
+import (
+	"github.com/ddelnano/terraform-provider-mikrotik/client/types"
+)
+
+// NeighborDiscoverySettings defines /ip/neighbor/discovery-settings settings
+//
+// Protocol is supported by RouterOS v7 only.
+type NeighborDiscoverySettings struct {
+	DiscoverInterfaceList string             `mikrotik:"discover-interface-list"`
+	Protocol              types.MikrotikList `mikrotik:"protocol"`
+}
+
+var (
+	_ Singleton = (*NeighborDiscoverySettings)(nil)
+	_ Resetter  = (*NeighborDiscoverySettings)(nil)
+)
+
+func (n *NeighborDiscoverySettings) ActionToCommand(action Action) string {
+	return map[Action]string{
+		Find:   "/ip/neighbor/discovery-settings/print",
+		Update: "/ip/neighbor/discovery-settings/set",
+	}[action]
+}
+
+func (n *NeighborDiscoverySettings) DefaultValues() map[string]string {
+	return map[string]string{
+		"discover-interface-list": "static",
+	}
+}
+
+func (client Mikrotik) FindNeighborDiscoverySettings() (*NeighborDiscoverySettings, error) {
+	res, err := client.FindSingleton(&NeighborDiscoverySettings{})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*NeighborDiscoverySettings), nil
+}
+
+func (client Mikrotik) UpdateNeighborDiscoverySettings(n *NeighborDiscoverySettings) (*NeighborDiscoverySettings, error) {
+	res, err := client.UpdateSingleton(n)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*NeighborDiscoverySettings), nil
+}
+
+func (client Mikrotik) ResetNeighborDiscoverySettings() error {
+	return client.ResetSingleton(&NeighborDiscoverySettings{})
+}
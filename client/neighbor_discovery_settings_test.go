@@ -0,0 +1,26 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNeighborDiscoverySettings_updateAndReset(t *testing.T) {
+	c := NewClient(GetConfigFromEnv())
+
+	defer func() {
+		require.NoError(t, c.ResetNeighborDiscoverySettings())
+
+		found, err := c.FindNeighborDiscoverySettings()
+		require.NoError(t, err)
+		assert.Equal(t, "static", found.DiscoverInterfaceList)
+	}()
+
+	updated, err := c.UpdateNeighborDiscoverySettings(&NeighborDiscoverySettings{
+		DiscoverInterfaceList: "none",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "none", updated.DiscoverInterfaceList)
+}
@@ -0,0 +1,61 @@
+package client
+
+import (
+	"github.com/ddelnano/terraform-provider-mikrotik/client/types"
+)
+
+// NtpClient defines /system/ntp/client settings
+//
+// RouterOS v6 configures upstream servers via PrimaryNtp and SecondaryNtp fields,
+// while RouterOS v7 uses Servers list instead.
+type NtpClient struct {
+	Enabled      bool               `mikrotik:"enabled"`
+	Mode         string             `mikrotik:"mode"`
+	Servers      types.MikrotikList `mikrotik:"servers"`
+	PrimaryNtp   string             `mikrotik:"primary-ntp"`
+	SecondaryNtp string             `mikrotik:"secondary-ntp"`
+}
+
+var (
+	_ Singleton = (*NtpClient)(nil)
+	_ Resetter  = (*NtpClient)(nil)
+)
+
+func (n *NtpClient) ActionToCommand(action Action) string {
+	return map[Action]string{
+		Find:   "/system/ntp/client/print",
+		Update: "/system/ntp/client/set",
+	}[action]
+}
+
+func (n *NtpClient) DefaultValues() map[string]string {
+	return map[string]string{
+		"enabled":       "no",
+		"mode":          "unicast",
+		"servers":       "",
+		"primary-ntp":   "0.0.0.0",
+		"secondary-ntp": "0.0.0.0",
+	}
+}
+
+func (client Mikrotik) FindNtpClient() (*NtpClient, error) {
+	res, err := client.FindSingleton(&NtpClient{})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*NtpClient), nil
+}
+
+func (client Mikrotik) UpdateNtpClient(n *NtpClient) (*NtpClient, error) {
+	res, err := client.UpdateSingleton(n)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*NtpClient), nil
+}
+
+func (client Mikrotik) ResetNtpClient() error {
+	return client.ResetSingleton(&NtpClient{})
+}
@@ -0,0 +1,62 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNtpClient_v6(t *testing.T) {
+	SkipIfRouterOSV7OrLater(t, sysResources)
+	c := NewClient(GetConfigFromEnv())
+
+	defer func() {
+		require.NoError(t, c.ResetNtpClient())
+
+		found, err := c.FindNtpClient()
+		require.NoError(t, err)
+		assert.False(t, found.Enabled)
+		assert.Equal(t, "0.0.0.0", found.PrimaryNtp)
+		assert.Equal(t, "0.0.0.0", found.SecondaryNtp)
+	}()
+
+	expected := &NtpClient{
+		Enabled:      true,
+		Mode:         "unicast",
+		PrimaryNtp:   "192.168.88.10",
+		SecondaryNtp: "192.168.88.11",
+	}
+	updated, err := c.UpdateNtpClient(expected)
+	require.NoError(t, err)
+	assert.Equal(t, expected, updated)
+}
+
+func TestNtpClient_v7(t *testing.T) {
+	SkipIfRouterOSV6OrEarlier(t, sysResources)
+	c := NewClient(GetConfigFromEnv())
+
+	defer func() {
+		require.NoError(t, c.ResetNtpClient())
+
+		found, err := c.FindNtpClient()
+		require.NoError(t, err)
+		assert.False(t, found.Enabled)
+		assert.Empty(t, found.Servers)
+	}()
+
+	expected := &NtpClient{
+		Enabled: true,
+		Mode:    "unicast",
+		Servers: types.MikrotikList{"192.168.88.10", "192.168.88.11"},
+	}
+	updated, err := c.UpdateNtpClient(expected)
+	require.NoError(t, err)
+	assert.Equal(t, expected, updated)
+
+	expected.Servers = nil
+	updated, err = c.UpdateNtpClient(expected)
+	require.NoError(t, err)
+	assert.Empty(t, updated.Servers)
+}
@@ -0,0 +1,93 @@
+package client
+
+import (
+	"github.com/go-routeros/routeros"
+)
+
+// OspfArea defines OSPF area in /routing/ospf/area menu, only supported by RouterOS v7+
+type OspfArea struct {
+	Id          string `mikrotik:".id" codegen:"id,mikrotikID"`
+	Name        string `mikrotik:"name" codegen:"name,required,terraformID"`
+	AreaId      string `mikrotik:"area-id" codegen:"area_id"`
+	Comment     string `mikrotik:"comment" codegen:"comment"`
+	DefaultCost int    `mikrotik:"default-cost" codegen:"default_cost"`
+	Disabled    bool   `mikrotik:"disabled" codegen:"disabled"`
+	Instance    string `mikrotik:"instance" codegen:"instance,required"`
+	NoSummaries bool   `mikrotik:"no-summaries" codegen:"no_summaries"`
+	Type        string `mikrotik:"type" codegen:"type"`
+}
+
+var _ Resource = (*OspfArea)(nil)
+
+func (b *OspfArea) ActionToCommand(a Action) string {
+	return map[Action]string{
+		Add:    "/routing/ospf/area/add",
+		Find:   "/routing/ospf/area/print",
+		Update: "/routing/ospf/area/set",
+		Delete: "/routing/ospf/area/remove",
+	}[a]
+}
+
+func (b *OspfArea) IDField() string {
+	return ".id"
+}
+
+func (b *OspfArea) ID() string {
+	return b.Id
+}
+
+func (b *OspfArea) SetID(id string) {
+	b.Id = id
+}
+
+func (b *OspfArea) AfterAddHook(r *routeros.Reply) {
+	b.Id = r.Done.Map["ret"]
+}
+
+func (b *OspfArea) FindField() string {
+	return "name"
+}
+
+func (b *OspfArea) FindFieldValue() string {
+	return b.Name
+}
+
+func (b *OspfArea) DeleteField() string {
+	return "numbers"
+}
+
+func (b *OspfArea) DeleteFieldValue() string {
+	return b.Name
+}
+
+// Typed wrappers
+func (c Mikrotik) AddOspfArea(r *OspfArea) (*OspfArea, error) {
+	res, err := c.Add(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*OspfArea), nil
+}
+
+func (c Mikrotik) UpdateOspfArea(r *OspfArea) (*OspfArea, error) {
+	res, err := c.Update(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*OspfArea), nil
+}
+
+func (c Mikrotik) FindOspfArea(name string) (*OspfArea, error) {
+	res, err := c.Find(&OspfArea{Name: name})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*OspfArea), nil
+}
+
+func (c Mikrotik) DeleteOspfArea(name string) error {
+	return c.Delete(&OspfArea{Name: name})
+}
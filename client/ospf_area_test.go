@@ -0,0 +1,37 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOspfArea_basic(t *testing.T) {
+	SkipIfRouterOSV6OrEarlier(t, sysResources)
+	c := NewClient(GetConfigFromEnv())
+
+	instance, err := c.AddOspfInstance(&OspfInstance{Name: "test-instance-" + RandomString(), Disabled: true})
+	require.NoError(t, err)
+	defer func(name string) {
+		assert.NoError(t, c.DeleteOspfInstance(name))
+	}(instance.Name)
+
+	area := &OspfArea{
+		Name:     "test-area-" + RandomString(),
+		AreaId:   "0.0.0.1",
+		Instance: instance.Name,
+		Type:     "stub",
+	}
+	created, err := c.AddOspfArea(area)
+	require.NoError(t, err)
+	defer func(name string) {
+		assert.NoError(t, c.DeleteOspfArea(name))
+	}(created.Name)
+
+	found, err := c.FindOspfArea(area.Name)
+	require.NoError(t, err)
+	assert.Equal(t, area.AreaId, found.AreaId)
+	assert.Equal(t, area.Instance, found.Instance)
+	assert.Equal(t, area.Type, found.Type)
+}
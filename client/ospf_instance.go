@@ -0,0 +1,98 @@
+package client
+
+import (
+	"github.com/ddelnano/terraform-provider-mikrotik/client/types"
+	"github.com/go-routeros/routeros"
+)
+
+// OspfInstance defines OSPF instance in /routing/ospf/instance menu, only supported by RouterOS v7+
+//
+// Version selects OSPFv2 for IPv4 or OSPFv3 for IPv6.
+type OspfInstance struct {
+	Id               string             `mikrotik:".id" codegen:"id,mikrotikID"`
+	Name             string             `mikrotik:"name" codegen:"name,required,terraformID"`
+	Comment          string             `mikrotik:"comment" codegen:"comment"`
+	Disabled         bool               `mikrotik:"disabled" codegen:"disabled"`
+	InFilterChain    string             `mikrotik:"in-filter-chain" codegen:"in_filter_chain"`
+	OriginateDefault string             `mikrotik:"originate-default" codegen:"originate_default"`
+	OutFilterChain   string             `mikrotik:"out-filter-chain" codegen:"out_filter_chain"`
+	Redistribute     types.MikrotikList `mikrotik:"redistribute" codegen:"redistribute"`
+	RouterId         string             `mikrotik:"router-id" codegen:"router_id"`
+	RoutingTable     string             `mikrotik:"routing-table" codegen:"routing_table"`
+	Version          int                `mikrotik:"version" codegen:"version"`
+}
+
+var _ Resource = (*OspfInstance)(nil)
+
+func (b *OspfInstance) ActionToCommand(a Action) string {
+	return map[Action]string{
+		Add:    "/routing/ospf/instance/add",
+		Find:   "/routing/ospf/instance/print",
+		Update: "/routing/ospf/instance/set",
+		Delete: "/routing/ospf/instance/remove",
+	}[a]
+}
+
+func (b *OspfInstance) IDField() string {
+	return ".id"
+}
+
+func (b *OspfInstance) ID() string {
+	return b.Id
+}
+
+func (b *OspfInstance) SetID(id string) {
+	b.Id = id
+}
+
+func (b *OspfInstance) AfterAddHook(r *routeros.Reply) {
+	b.Id = r.Done.Map["ret"]
+}
+
+func (b *OspfInstance) FindField() string {
+	return "name"
+}
+
+func (b *OspfInstance) FindFieldValue() string {
+	return b.Name
+}
+
+func (b *OspfInstance) DeleteField() string {
+	return "numbers"
+}
+
+func (b *OspfInstance) DeleteFieldValue() string {
+	return b.Name
+}
+
+// Typed wrappers
+func (c Mikrotik) AddOspfInstance(r *OspfInstance) (*OspfInstance, error) {
+	res, err := c.Add(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*OspfInstance), nil
+}
+
+func (c Mikrotik) UpdateOspfInstance(r *OspfInstance) (*OspfInstance, error) {
+	res, err := c.Update(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*OspfInstance), nil
+}
+
+func (c Mikrotik) FindOspfInstance(name string) (*OspfInstance, error) {
+	res, err := c.Find(&OspfInstance{Name: name})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*OspfInstance), nil
+}
+
+func (c Mikrotik) DeleteOspfInstance(name string) error {
+	return c.Delete(&OspfInstance{Name: name})
+}
@@ -0,0 +1,39 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOspfInstance_basic(t *testing.T) {
+	SkipIfRouterOSV6OrEarlier(t, sysResources)
+	c := NewClient(GetConfigFromEnv())
+
+	instance := &OspfInstance{
+		Name:             "test-instance-" + RandomString(),
+		Disabled:         true,
+		OriginateDefault: "never",
+		Redistribute:     types.MikrotikList{"connected", "static"},
+		RouterId:         "10.255.0.1",
+		Version:          2,
+	}
+	created, err := c.AddOspfInstance(instance)
+	require.NoError(t, err)
+	defer func(name string) {
+		assert.NoError(t, c.DeleteOspfInstance(name))
+	}(created.Name)
+
+	found, err := c.FindOspfInstance(instance.Name)
+	require.NoError(t, err)
+	assert.Equal(t, instance.Redistribute, found.Redistribute)
+	assert.Equal(t, instance.RouterId, found.RouterId)
+	assert.Equal(t, instance.Version, found.Version)
+
+	found.OriginateDefault = "always"
+	updated, err := c.UpdateOspfInstance(found)
+	require.NoError(t, err)
+	assert.Equal(t, "always", updated.OriginateDefault)
+}
@@ -0,0 +1,86 @@
+package client
+
+import (
+	"github.com/ddelnano/terraform-provider-mikrotik/client/types"
+	"github.com/go-routeros/routeros"
+)
+
+// OspfInterfaceTemplate defines OSPF interface template in /routing/ospf/interface-template menu, only supported by RouterOS v7+
+//
+// Templates enable OSPF on interfaces matching Interfaces or Networks.
+type OspfInterfaceTemplate struct {
+	Id            string                 `mikrotik:".id" codegen:"id,mikrotikID"`
+	Area          string                 `mikrotik:"area" codegen:"area,required"`
+	Auth          string                 `mikrotik:"auth" codegen:"auth"`
+	AuthId        int                    `mikrotik:"auth-id" codegen:"auth_id"`
+	AuthKey       string                 `mikrotik:"auth-key" codegen:"auth_key"`
+	Comment       string                 `mikrotik:"comment" codegen:"comment"`
+	Cost          int                    `mikrotik:"cost" codegen:"cost"`
+	DeadInterval  types.MikrotikDuration `mikrotik:"dead-interval" codegen:"dead_interval"`
+	Disabled      bool                   `mikrotik:"disabled" codegen:"disabled"`
+	HelloInterval types.MikrotikDuration `mikrotik:"hello-interval" codegen:"hello_interval"`
+	Interfaces    types.MikrotikList     `mikrotik:"interfaces" codegen:"interfaces"`
+	Networks      types.MikrotikList     `mikrotik:"networks" codegen:"networks"`
+	Passive       bool                   `mikrotik:"passive" codegen:"passive"`
+	Priority      int                    `mikrotik:"priority" codegen:"priority"`
+	Type          string                 `mikrotik:"type" codegen:"type"`
+}
+
+var _ Resource = (*OspfInterfaceTemplate)(nil)
+
+func (b *OspfInterfaceTemplate) ActionToCommand(a Action) string {
+	return map[Action]string{
+		Add:    "/routing/ospf/interface-template/add",
+		Find:   "/routing/ospf/interface-template/print",
+		Update: "/routing/ospf/interface-template/set",
+		Delete: "/routing/ospf/interface-template/remove",
+	}[a]
+}
+
+func (b *OspfInterfaceTemplate) IDField() string {
+	return ".id"
+}
+
+func (b *OspfInterfaceTemplate) ID() string {
+	return b.Id
+}
+
+func (b *OspfInterfaceTemplate) SetID(id string) {
+	b.Id = id
+}
+
+func (b *OspfInterfaceTemplate) AfterAddHook(r *routeros.Reply) {
+	b.Id = r.Done.Map["ret"]
+}
+
+// Typed wrappers
+func (c Mikrotik) AddOspfInterfaceTemplate(r *OspfInterfaceTemplate) (*OspfInterfaceTemplate, error) {
+	res, err := c.Add(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*OspfInterfaceTemplate), nil
+}
+
+func (c Mikrotik) UpdateOspfInterfaceTemplate(r *OspfInterfaceTemplate) (*OspfInterfaceTemplate, error) {
+	res, err := c.Update(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*OspfInterfaceTemplate), nil
+}
+
+func (c Mikrotik) FindOspfInterfaceTemplate(id string) (*OspfInterfaceTemplate, error) {
+	res, err := c.Find(&OspfInterfaceTemplate{Id: id})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*OspfInterfaceTemplate), nil
+}
+
+func (c Mikrotik) DeleteOspfInterfaceTemplate(id string) error {
+	return c.Delete(&OspfInterfaceTemplate{Id: id})
+}
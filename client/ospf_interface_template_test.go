@@ -0,0 +1,48 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOspfInterfaceTemplate_basic(t *testing.T) {
+	SkipIfRouterOSV6OrEarlier(t, sysResources)
+	c := NewClient(GetConfigFromEnv())
+
+	instance, err := c.AddOspfInstance(&OspfInstance{Name: "test-instance-" + RandomString(), Disabled: true})
+	require.NoError(t, err)
+	defer func(name string) {
+		assert.NoError(t, c.DeleteOspfInstance(name))
+	}(instance.Name)
+
+	area, err := c.AddOspfArea(&OspfArea{Name: "test-area-" + RandomString(), AreaId: "0.0.0.0", Instance: instance.Name})
+	require.NoError(t, err)
+	defer func(name string) {
+		assert.NoError(t, c.DeleteOspfArea(name))
+	}(area.Name)
+
+	template := &OspfInterfaceTemplate{
+		Area:     area.Name,
+		Cost:     20,
+		Networks: types.MikrotikList{"10.80.0.0/24"},
+		Passive:  true,
+		Priority: 10,
+		Type:     "ptp",
+	}
+	created, err := c.AddOspfInterfaceTemplate(template)
+	require.NoError(t, err)
+	defer func(id string) {
+		assert.NoError(t, c.DeleteOspfInterfaceTemplate(id))
+	}(created.Id)
+
+	found, err := c.FindOspfInterfaceTemplate(created.Id)
+	require.NoError(t, err)
+	assert.Equal(t, template.Area, found.Area)
+	assert.Equal(t, template.Cost, found.Cost)
+	assert.Equal(t, template.Networks, found.Networks)
+	assert.True(t, found.Passive)
+	assert.Equal(t, template.Type, found.Type)
+}
@@ -0,0 +1,56 @@
+package client
+
+import (
+	"github.com/ddelnano/terraform-provider-mikrotik/client/types"
+)
+
+// PppAaa defines /ppp/aaa settings, which control authentication and accounting of PPP sessions via RADIUS
+type PppAaa struct {
+	Accounting              bool                   `mikrotik:"accounting"`
+	InterimUpdate           types.MikrotikDuration `mikrotik:"interim-update"`
+	UseCircuitIdInNasPortId bool                   `mikrotik:"use-circuit-id-in-nas-port-id"`
+	UseRadius               bool                   `mikrotik:"use-radius"`
+}
+
+var (
+	_ Singleton = (*PppAaa)(nil)
+	_ Resetter  = (*PppAaa)(nil)
+)
+
+func (p *PppAaa) ActionToCommand(action Action) string {
+	return map[Action]string{
+		Find:   "/ppp/aaa/print",
+		Update: "/ppp/aaa/set",
+	}[action]
+}
+
+func (p *PppAaa) DefaultValues() map[string]string {
+	return map[string]string{
+		"accounting":                    "yes",
+		"interim-update":                "0s",
+		"use-circuit-id-in-nas-port-id": "no",
+		"use-radius":                    "no",
+	}
+}
+
+func (client Mikrotik) FindPppAaa() (*PppAaa, error) {
+	res, err := client.FindSingleton(&PppAaa{})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*PppAaa), nil
+}
+
+func (client Mikrotik) UpdatePppAaa(p *PppAaa) (*PppAaa, error) {
+	res, err := client.UpdateSingleton(p)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*PppAaa), nil
+}
+
+func (client Mikrotik) ResetPppAaa() error {
+	return client.ResetSingleton(&PppAaa{})
+}
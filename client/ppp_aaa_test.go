@@ -0,0 +1,30 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPppAaa_basic(t *testing.T) {
+	c := NewClient(GetConfigFromEnv())
+
+	defer func() {
+		require.NoError(t, c.ResetPppAaa())
+
+		found, err := c.FindPppAaa()
+		require.NoError(t, err)
+		assert.False(t, found.UseRadius)
+	}()
+
+	expected := &PppAaa{
+		Accounting:    true,
+		InterimUpdate: types.MikrotikDuration(300),
+		UseRadius:     true,
+	}
+	updated, err := c.UpdatePppAaa(expected)
+	require.NoError(t, err)
+	assert.Equal(t, expected, updated)
+}
@@ -0,0 +1,79 @@
+package client
+
+import (
+	"github.com/ddelnano/terraform-provider-mikrotik/client/types"
+	"github.com/go-routeros/routeros"
+)
+
+// Radius defines RADIUS server used by the router in /radius menu
+type Radius struct {
+	Id                 string             `mikrotik:".id" codegen:"id,mikrotikID"`
+	AccountingPort     int                `mikrotik:"accounting-port" codegen:"accounting_port"`
+	Address            string             `mikrotik:"address" codegen:"address,required"`
+	AuthenticationPort int                `mikrotik:"authentication-port" codegen:"authentication_port"`
+	Comment            string             `mikrotik:"comment" codegen:"comment"`
+	Disabled           bool               `mikrotik:"disabled" codegen:"disabled"`
+	Secret             string             `mikrotik:"secret" codegen:"secret,required"`
+	Service            types.MikrotikList `mikrotik:"service" codegen:"service,required"`
+	SrcAddress         string             `mikrotik:"src-address" codegen:"src_address"`
+	Timeout            string             `mikrotik:"timeout" codegen:"timeout"`
+}
+
+var _ Resource = (*Radius)(nil)
+
+func (b *Radius) ActionToCommand(a Action) string {
+	return map[Action]string{
+		Add:    "/radius/add",
+		Find:   "/radius/print",
+		Update: "/radius/set",
+		Delete: "/radius/remove",
+	}[a]
+}
+
+func (b *Radius) IDField() string {
+	return ".id"
+}
+
+func (b *Radius) ID() string {
+	return b.Id
+}
+
+func (b *Radius) SetID(id string) {
+	b.Id = id
+}
+
+func (b *Radius) AfterAddHook(r *routeros.Reply) {
+	b.Id = r.Done.Map["ret"]
+}
+
+// Typed wrappers
+func (c Mikrotik) AddRadius(r *Radius) (*Radius, error) {
+	res, err := c.Add(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*Radius), nil
+}
+
+func (c Mikrotik) UpdateRadius(r *Radius) (*Radius, error) {
+	res, err := c.Update(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*Radius), nil
+}
+
+func (c Mikrotik) FindRadius(id string) (*Radius, error) {
+	res, err := c.Find(&Radius{Id: id})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*Radius), nil
+}
+
+func (c Mikrotik) DeleteRadius(id string) error {
+	return c.Delete(&Radius{Id: id})
+}
@@ -0,0 +1,39 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRadius_basic(t *testing.T) {
+	c := NewClient(GetConfigFromEnv())
+
+	radius := &Radius{
+		AccountingPort:     1813,
+		Address:            "192.168.88.20",
+		AuthenticationPort: 1812,
+		Comment:            "Test RADIUS server",
+		Secret:             "test-" + RandomString(),
+		Service:            types.MikrotikList{"login", "ppp"},
+		SrcAddress:         "0.0.0.0",
+		Timeout:            "300ms",
+	}
+
+	created, err := c.AddRadius(radius)
+	require.NoError(t, err)
+	defer func(id string) {
+		assert.NoError(t, c.DeleteRadius(id))
+	}(created.Id)
+
+	radius.Id = created.Id
+	assert.Equal(t, radius, created)
+
+	radius.Service = types.MikrotikList{"login"}
+	radius.Timeout = "1s"
+	updated, err := c.UpdateRadius(radius)
+	require.NoError(t, err)
+	assert.Equal(t, radius, updated)
+}
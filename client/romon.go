@@ -0,0 +1,56 @@
+package client
+
+import (
+	"github.com/ddelnano/terraform-provider-mikrotik/client/types"
+)
+
+// Romon defines /tool/romon settings
+//
+// RomonId holds RoMON ID of the router, it is named so to avoid confusion with resource IDs.
+type Romon struct {
+	Enabled bool               `mikrotik:"enabled"`
+	RomonId string             `mikrotik:"id"`
+	Secrets types.MikrotikList `mikrotik:"secrets"`
+}
+
+var (
+	_ Singleton = (*Romon)(nil)
+	_ Resetter  = (*Romon)(nil)
+)
+
+func (r *Romon) ActionToCommand(action Action) string {
+	return map[Action]string{
+		Find:   "/tool/romon/print",
+		Update: "/tool/romon/set",
+	}[action]
+}
+
+func (r *Romon) DefaultValues() map[string]string {
+	return map[string]string{
+		"enabled": "no",
+		"id":      "00:00:00:00:00:00",
+		"secrets": "",
+	}
+}
+
+func (client Mikrotik) FindRomon() (*Romon, error) {
+	res, err := client.FindSingleton(&Romon{})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*Romon), nil
+}
+
+func (client Mikrotik) UpdateRomon(r *Romon) (*Romon, error) {
+	res, err := client.UpdateSingleton(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*Romon), nil
+}
+
+func (client Mikrotik) ResetRomon() error {
+	return client.ResetSingleton(&Romon{})
+}
@@ -0,0 +1,32 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRomon_updateAndReset(t *testing.T) {
+	c := NewClient(GetConfigFromEnv())
+
+	defer func() {
+		require.NoError(t, c.ResetRomon())
+
+		found, err := c.FindRomon()
+		require.NoError(t, err)
+		assert.False(t, found.Enabled)
+		assert.Empty(t, found.Secrets)
+	}()
+
+	updated, err := c.UpdateRomon(&Romon{
+		Enabled: true,
+		RomonId: "00:00:00:00:00:00",
+		Secrets: types.MikrotikList{"first-secret", "second-secret"},
+	})
+	require.NoError(t, err)
+	assert.True(t, updated.Enabled)
+	assert.ElementsMatch(t, types.MikrotikList{"first-secret", "second-secret"}, updated.Secrets)
+}
@@ -0,0 +1,96 @@
+package client
+
+import (
+	"github.com/go-routeros/routeros"
+)
+
+// RoutingFilterRule defines routing filter rule in /routing/filter/rule menu, only supported by RouterOS v7+
+//
+// Rules of the same chain are evaluated in order they are placed in the table.
+type RoutingFilterRule struct {
+	Id       string `mikrotik:".id" codegen:"id,mikrotikID"`
+	Chain    string `mikrotik:"chain" codegen:"chain,required"`
+	Comment  string `mikrotik:"comment" codegen:"comment"`
+	Disabled bool   `mikrotik:"disabled" codegen:"disabled"`
+	Dynamic  bool   `mikrotik:"dynamic,readonly" codegen:"dynamic,computed"`
+	Rule     string `mikrotik:"rule" codegen:"rule,required"`
+}
+
+var _ Resource = (*RoutingFilterRule)(nil)
+
+func (b *RoutingFilterRule) ActionToCommand(a Action) string {
+	return map[Action]string{
+		Add:    "/routing/filter/rule/add",
+		Find:   "/routing/filter/rule/print",
+		List:   "/routing/filter/rule/print",
+		Update: "/routing/filter/rule/set",
+		Delete: "/routing/filter/rule/remove",
+		Move:   "/routing/filter/rule/move",
+	}[a]
+}
+
+func (b *RoutingFilterRule) IDField() string {
+	return ".id"
+}
+
+func (b *RoutingFilterRule) ID() string {
+	return b.Id
+}
+
+func (b *RoutingFilterRule) SetID(id string) {
+	b.Id = id
+}
+
+func (b *RoutingFilterRule) AfterAddHook(r *routeros.Reply) {
+	b.Id = r.Done.Map["ret"]
+}
+
+// Typed wrappers
+func (c Mikrotik) AddRoutingFilterRule(r *RoutingFilterRule) (*RoutingFilterRule, error) {
+	res, err := c.Add(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*RoutingFilterRule), nil
+}
+
+func (c Mikrotik) UpdateRoutingFilterRule(r *RoutingFilterRule) (*RoutingFilterRule, error) {
+	res, err := c.Update(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*RoutingFilterRule), nil
+}
+
+func (c Mikrotik) FindRoutingFilterRule(id string) (*RoutingFilterRule, error) {
+	res, err := c.Find(&RoutingFilterRule{Id: id})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*RoutingFilterRule), nil
+}
+
+func (c Mikrotik) ListRoutingFilterRules() ([]RoutingFilterRule, error) {
+	res, err := c.List(&RoutingFilterRule{})
+	if err != nil {
+		return nil, err
+	}
+	returnSlice := make([]RoutingFilterRule, len(res))
+	for i, v := range res {
+		returnSlice[i] = *(v.(*RoutingFilterRule))
+	}
+
+	return returnSlice, nil
+}
+
+// MoveRoutingFilterRule places the rule right before destination rule
+func (c Mikrotik) MoveRoutingFilterRule(id, destinationID string) error {
+	return c.Move(&RoutingFilterRule{Id: id}, destinationID)
+}
+
+func (c Mikrotik) DeleteRoutingFilterRule(id string) error {
+	return c.Delete(&RoutingFilterRule{Id: id})
+}
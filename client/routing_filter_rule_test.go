@@ -0,0 +1,37 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoutingFilterRule_basic(t *testing.T) {
+	SkipIfRouterOSV6OrEarlier(t, sysResources)
+	c := NewClient(GetConfigFromEnv())
+
+	rule := &RoutingFilterRule{
+		Chain:    "test-chain",
+		Comment:  "Test routing filter rule",
+		Disabled: true,
+		Rule:     "if (dst in 10.0.0.0/8) { reject; }",
+	}
+
+	created, err := c.AddRoutingFilterRule(rule)
+	require.NoError(t, err)
+
+	defer func(id string) {
+		assert.NoError(t, c.DeleteRoutingFilterRule(id))
+	}(created.Id)
+
+	rule.Id = created.Id
+	found, err := c.FindRoutingFilterRule(rule.Id)
+	require.NoError(t, err)
+	assert.Equal(t, rule, found)
+
+	rule.Rule = "if (dst in 172.16.0.0/12) { reject; }"
+	updated, err := c.UpdateRoutingFilterRule(rule)
+	require.NoError(t, err)
+	assert.Equal(t, rule, updated)
+}
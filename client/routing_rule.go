@@ -0,0 +1,98 @@
+package client
+
+import (
+	"github.com/go-routeros/routeros"
+)
+
+// RoutingRule defines policy routing rule in /routing/rule menu, only supported by RouterOS v7+
+type RoutingRule struct {
+	Id          string `mikrotik:".id" codegen:"id,mikrotikID"`
+	Action      string `mikrotik:"action" codegen:"action"`
+	Comment     string `mikrotik:"comment" codegen:"comment"`
+	Disabled    bool   `mikrotik:"disabled" codegen:"disabled"`
+	DstAddress  string `mikrotik:"dst-address" codegen:"dst_address"`
+	Interface   string `mikrotik:"interface" codegen:"interface"`
+	MinPrefix   int    `mikrotik:"min-prefix" codegen:"min_prefix"`
+	RoutingMark string `mikrotik:"routing-mark" codegen:"routing_mark"`
+	SrcAddress  string `mikrotik:"src-address" codegen:"src_address"`
+	Table       string `mikrotik:"table" codegen:"table"`
+}
+
+var _ Resource = (*RoutingRule)(nil)
+
+func (b *RoutingRule) ActionToCommand(a Action) string {
+	return map[Action]string{
+		Add:    "/routing/rule/add",
+		Find:   "/routing/rule/print",
+		List:   "/routing/rule/print",
+		Update: "/routing/rule/set",
+		Delete: "/routing/rule/remove",
+		Move:   "/routing/rule/move",
+	}[a]
+}
+
+func (b *RoutingRule) IDField() string {
+	return ".id"
+}
+
+func (b *RoutingRule) ID() string {
+	return b.Id
+}
+
+func (b *RoutingRule) SetID(id string) {
+	b.Id = id
+}
+
+func (b *RoutingRule) AfterAddHook(r *routeros.Reply) {
+	b.Id = r.Done.Map["ret"]
+}
+
+// Typed wrappers
+func (c Mikrotik) AddRoutingRule(r *RoutingRule) (*RoutingRule, error) {
+	res, err := c.Add(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*RoutingRule), nil
+}
+
+func (c Mikrotik) UpdateRoutingRule(r *RoutingRule) (*RoutingRule, error) {
+	res, err := c.Update(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*RoutingRule), nil
+}
+
+func (c Mikrotik) FindRoutingRule(id string) (*RoutingRule, error) {
+	res, err := c.Find(&RoutingRule{Id: id})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*RoutingRule), nil
+}
+
+func (c Mikrotik) ListRoutingRules() ([]RoutingRule, error) {
+	res, err := c.List(&RoutingRule{})
+	if err != nil {
+		return nil, err
+	}
+	returnSlice := make([]RoutingRule, len(res))
+	for i, v := range res {
+		returnSlice[i] = *(v.(*RoutingRule))
+	}
+
+	return returnSlice, nil
+}
+
+// MoveRoutingRule places the rule right before destination rule
+func (c Mikrotik) MoveRoutingRule(id, destinationID string) error {
+	return c.Move(&RoutingRule{Id: id}, destinationID)
+}
+
+func (c Mikrotik) DeleteRoutingRule(id string) error {
+	return c.Delete(&RoutingRule{Id: id})
+}
@@ -0,0 +1,56 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoutingRule_basic(t *testing.T) {
+	SkipIfRouterOSV6OrEarlier(t, sysResources)
+	c := NewClient(GetConfigFromEnv())
+
+	rule := &RoutingRule{
+		Action:     "lookup-only-in-table",
+		Comment:    "Test routing rule",
+		SrcAddress: "10.79.0.0/24",
+		Table:      "main",
+		Disabled:   true,
+	}
+
+	created, err := c.AddRoutingRule(rule)
+	require.NoError(t, err)
+
+	defer func(id string) {
+		assert.NoError(t, c.DeleteRoutingRule(id))
+	}(created.Id)
+
+	rule.Id = created.Id
+	found, err := c.FindRoutingRule(rule.Id)
+	require.NoError(t, err)
+	assert.Equal(t, rule, found)
+}
+
+func TestRoutingRule_move(t *testing.T) {
+	SkipIfRouterOSV6OrEarlier(t, sysResources)
+	c := NewClient(GetConfigFromEnv())
+
+	first, err := c.AddRoutingRule(&RoutingRule{Action: "lookup", SrcAddress: "10.79.1.0/24", Table: "main", Disabled: true})
+	require.NoError(t, err)
+	defer func() { assert.NoError(t, c.DeleteRoutingRule(first.Id)) }()
+
+	second, err := c.AddRoutingRule(&RoutingRule{Action: "lookup", SrcAddress: "10.79.2.0/24", Table: "main", Disabled: true})
+	require.NoError(t, err)
+	defer func() { assert.NoError(t, c.DeleteRoutingRule(second.Id)) }()
+
+	require.NoError(t, c.MoveRoutingRule(second.Id, first.Id))
+
+	rules, err := c.ListRoutingRules()
+	require.NoError(t, err)
+	positions := map[string]int{}
+	for i, r := range rules {
+		positions[r.Id] = i
+	}
+	assert.Less(t, positions[second.Id], positions[first.Id])
+}
@@ -0,0 +1,90 @@
+package client
+
+import (
+	"github.com/go-routeros/routeros"
+)
+
+// RoutingTable defines routing table in /routing/table menu, only supported by RouterOS v7+
+type RoutingTable struct {
+	Id       string `mikrotik:".id" codegen:"id,mikrotikID"`
+	Comment  string `mikrotik:"comment" codegen:"comment"`
+	Disabled bool   `mikrotik:"disabled" codegen:"disabled"`
+	Dynamic  bool   `mikrotik:"dynamic,readonly" codegen:"dynamic,computed"`
+	Fib      bool   `mikrotik:"fib,omitempty" codegen:"fib"`
+	Name     string `mikrotik:"name" codegen:"name,terraformID,required"`
+}
+
+var _ Resource = (*RoutingTable)(nil)
+
+func (b *RoutingTable) ActionToCommand(a Action) string {
+	return map[Action]string{
+		Add:    "/routing/table/add",
+		Find:   "/routing/table/print",
+		Update: "/routing/table/set",
+		Delete: "/routing/table/remove",
+	}[a]
+}
+
+func (b *RoutingTable) IDField() string {
+	return ".id"
+}
+
+func (b *RoutingTable) ID() string {
+	return b.Id
+}
+
+func (b *RoutingTable) SetID(id string) {
+	b.Id = id
+}
+
+func (b *RoutingTable) AfterAddHook(r *routeros.Reply) {
+	b.Id = r.Done.Map["ret"]
+}
+
+func (b *RoutingTable) FindField() string {
+	return "name"
+}
+
+func (b *RoutingTable) FindFieldValue() string {
+	return b.Name
+}
+
+func (b *RoutingTable) DeleteField() string {
+	return "numbers"
+}
+
+func (b *RoutingTable) DeleteFieldValue() string {
+	return b.Name
+}
+
+// Typed wrappers
+func (c Mikrotik) AddRoutingTable(r *RoutingTable) (*RoutingTable, error) {
+	res, err := c.Add(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*RoutingTable), nil
+}
+
+func (c Mikrotik) UpdateRoutingTable(r *RoutingTable) (*RoutingTable, error) {
+	res, err := c.Update(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*RoutingTable), nil
+}
+
+func (c Mikrotik) FindRoutingTable(name string) (*RoutingTable, error) {
+	res, err := c.Find(&RoutingTable{Name: name})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*RoutingTable), nil
+}
+
+func (c Mikrotik) DeleteRoutingTable(name string) error {
+	return c.Delete(&RoutingTable{Name: name})
+}
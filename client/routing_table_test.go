@@ -0,0 +1,36 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoutingTable_basic(t *testing.T) {
+	SkipIfRouterOSV6OrEarlier(t, sysResources)
+	c := NewClient(GetConfigFromEnv())
+
+	table := &RoutingTable{
+		Name:    "test-table-" + RandomString(),
+		Comment: "Test routing table",
+		Fib:     true,
+	}
+
+	created, err := c.AddRoutingTable(table)
+	require.NoError(t, err)
+
+	defer func(name string) {
+		assert.NoError(t, c.DeleteRoutingTable(name))
+	}(created.Name)
+
+	table.Id = created.Id
+	found, err := c.FindRoutingTable(table.Name)
+	require.NoError(t, err)
+	assert.Equal(t, table, found)
+
+	table.Comment = "Updated routing table"
+	updated, err := c.UpdateRoutingTable(table)
+	require.NoError(t, err)
+	assert.Equal(t, table, updated)
+}
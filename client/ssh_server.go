@@ -0,0 +1,55 @@
+package client
+
+// SshServer defines /ip/ssh settings
+//
+// HostKeyType is supported by RouterOS v7 only and is not reset, since changing it regenerates the host key.
+type SshServer struct {
+	AllowNoneCrypto          bool   `mikrotik:"allow-none-crypto"`
+	AlwaysAllowPasswordLogin bool   `mikrotik:"always-allow-password-login"`
+	ForwardingEnabled        string `mikrotik:"forwarding-enabled"`
+	HostKeyType              string `mikrotik:"host-key-type"`
+	StrongCrypto             bool   `mikrotik:"strong-crypto"`
+}
+
+var (
+	_ Singleton = (*SshServer)(nil)
+	_ Resetter  = (*SshServer)(nil)
+)
+
+func (s *SshServer) ActionToCommand(action Action) string {
+	return map[Action]string{
+		Find:   "/ip/ssh/print",
+		Update: "/ip/ssh/set",
+	}[action]
+}
+
+func (s *SshServer) DefaultValues() map[string]string {
+	return map[string]string{
+		"allow-none-crypto":           "no",
+		"always-allow-password-login": "no",
+		"forwarding-enabled":          "no",
+		"strong-crypto":               "no",
+	}
+}
+
+func (client Mikrotik) FindSshServer() (*SshServer, error) {
+	res, err := client.FindSingleton(&SshServer{})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*SshServer), nil
+}
+
+func (client Mikrotik) UpdateSshServer(s *SshServer) (*SshServer, error) {
+	res, err := client.UpdateSingleton(s)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*SshServer), nil
+}
+
+func (client Mikrotik) ResetSshServer() error {
+	return client.ResetSingleton(&SshServer{})
+}
@@ -0,0 +1,30 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSshServer_updateAndReset(t *testing.T) {
+	c := NewClient(GetConfigFromEnv())
+
+	defer func() {
+		require.NoError(t, c.ResetSshServer())
+
+		found, err := c.FindSshServer()
+		require.NoError(t, err)
+		assert.False(t, found.AlwaysAllowPasswordLogin)
+		assert.False(t, found.StrongCrypto)
+	}()
+
+	updated, err := c.UpdateSshServer(&SshServer{
+		AlwaysAllowPasswordLogin: true,
+		StrongCrypto:             true,
+	})
+	require.NoError(t, err)
+	assert.True(t, updated.AlwaysAllowPasswordLogin)
+	assert.True(t, updated.StrongCrypto)
+	assert.False(t, updated.AllowNoneCrypto)
+}
@@ -0,0 +1,48 @@
+package client
+
+// SystemClock defines /system/clock settings
+type SystemClock struct {
+	TimeZoneName       string `mikrotik:"time-zone-name"`
+	TimeZoneAutodetect bool   `mikrotik:"time-zone-autodetect"`
+	GmtOffset          string `mikrotik:"gmt-offset,readonly"`
+}
+
+var (
+	_ Singleton = (*SystemClock)(nil)
+	_ Resetter  = (*SystemClock)(nil)
+)
+
+func (s *SystemClock) ActionToCommand(action Action) string {
+	return map[Action]string{
+		Find:   "/system/clock/print",
+		Update: "/system/clock/set",
+	}[action]
+}
+
+func (s *SystemClock) DefaultValues() map[string]string {
+	return map[string]string{
+		"time-zone-autodetect": "yes",
+	}
+}
+
+func (client Mikrotik) FindSystemClock() (*SystemClock, error) {
+	res, err := client.FindSingleton(&SystemClock{})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*SystemClock), nil
+}
+
+func (client Mikrotik) UpdateSystemClock(s *SystemClock) (*SystemClock, error) {
+	res, err := client.UpdateSingleton(s)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*SystemClock), nil
+}
+
+func (client Mikrotik) ResetSystemClock() error {
+	return client.ResetSingleton(&SystemClock{})
+}
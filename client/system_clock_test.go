@@ -0,0 +1,29 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSystemClock_updateAndReset(t *testing.T) {
+	c := NewClient(GetConfigFromEnv())
+
+	defer func() {
+		require.NoError(t, c.ResetSystemClock())
+
+		found, err := c.FindSystemClock()
+		require.NoError(t, err)
+		assert.True(t, found.TimeZoneAutodetect)
+	}()
+
+	updated, err := c.UpdateSystemClock(&SystemClock{
+		TimeZoneName:       "Europe/Riga",
+		TimeZoneAutodetect: false,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Europe/Riga", updated.TimeZoneName)
+	assert.False(t, updated.TimeZoneAutodetect)
+	assert.NotEmpty(t, updated.GmtOffset)
+}
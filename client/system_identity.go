@@ -0,0 +1,46 @@
+package client
+
+// SystemIdentity defines /system/identity settings
+type SystemIdentity struct {
+	Name string `mikrotik:"name"`
+}
+
+var (
+	_ Singleton = (*SystemIdentity)(nil)
+	_ Resetter  = (*SystemIdentity)(nil)
+)
+
+func (s *SystemIdentity) ActionToCommand(action Action) string {
+	return map[Action]string{
+		Find:   "/system/identity/print",
+		Update: "/system/identity/set",
+	}[action]
+}
+
+func (s *SystemIdentity) DefaultValues() map[string]string {
+	return map[string]string{
+		"name": "MikroTik",
+	}
+}
+
+func (client Mikrotik) FindSystemIdentity() (*SystemIdentity, error) {
+	res, err := client.FindSingleton(&SystemIdentity{})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*SystemIdentity), nil
+}
+
+func (client Mikrotik) UpdateSystemIdentity(s *SystemIdentity) (*SystemIdentity, error) {
+	res, err := client.UpdateSingleton(s)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*SystemIdentity), nil
+}
+
+func (client Mikrotik) ResetSystemIdentity() error {
+	return client.ResetSingleton(&SystemIdentity{})
+}
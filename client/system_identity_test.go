@@ -0,0 +1,29 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSystemIdentity_updateAndReset(t *testing.T) {
+	c := NewClient(GetConfigFromEnv())
+
+	original, err := c.FindSystemIdentity()
+	require.NoError(t, err)
+	defer func() {
+		_, err := c.UpdateSystemIdentity(original)
+		require.NoError(t, err)
+	}()
+
+	expected := &SystemIdentity{Name: "router-" + RandomString()}
+	updated, err := c.UpdateSystemIdentity(expected)
+	require.NoError(t, err)
+	assert.Equal(t, expected, updated)
+
+	require.NoError(t, c.ResetSystemIdentity())
+	found, err := c.FindSystemIdentity()
+	require.NoError(t, err)
+	assert.Equal(t, "MikroTik", found.Name)
+}
@@ -0,0 +1,94 @@
+package client
+
+import (
+	"github.com/ddelnano/terraform-provider-mikrotik/client/types"
+	"github.com/go-routeros/routeros"
+)
+
+// User defines system user in /user menu
+//
+// RouterOS never reports the password back, so it is only sent on add and set.
+type User struct {
+	Id       string             `mikrotik:".id" codegen:"id,mikrotikID"`
+	Name     string             `mikrotik:"name" codegen:"name,terraformID,required"`
+	Address  types.MikrotikList `mikrotik:"address,clearable" codegen:"address"`
+	Comment  string             `mikrotik:"comment" codegen:"comment"`
+	Disabled bool               `mikrotik:"disabled" codegen:"disabled"`
+	Group    string             `mikrotik:"group" codegen:"group,required"`
+	Password string             `mikrotik:"password" codegen:"password"`
+}
+
+var _ Resource = (*User)(nil)
+
+func (b *User) ActionToCommand(a Action) string {
+	return map[Action]string{
+		Add:    "/user/add",
+		Find:   "/user/print",
+		Update: "/user/set",
+		Delete: "/user/remove",
+	}[a]
+}
+
+func (b *User) IDField() string {
+	return ".id"
+}
+
+func (b *User) ID() string {
+	return b.Id
+}
+
+func (b *User) SetID(id string) {
+	b.Id = id
+}
+
+func (b *User) AfterAddHook(r *routeros.Reply) {
+	b.Id = r.Done.Map["ret"]
+}
+
+func (b *User) FindField() string {
+	return "name"
+}
+
+func (b *User) FindFieldValue() string {
+	return b.Name
+}
+
+func (b *User) DeleteField() string {
+	return "numbers"
+}
+
+func (b *User) DeleteFieldValue() string {
+	return b.Name
+}
+
+// Typed wrappers
+func (c Mikrotik) AddUser(r *User) (*User, error) {
+	res, err := c.Add(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*User), nil
+}
+
+func (c Mikrotik) UpdateUser(r *User) (*User, error) {
+	res, err := c.Update(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*User), nil
+}
+
+func (c Mikrotik) FindUser(name string) (*User, error) {
+	res, err := c.Find(&User{Name: name})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*User), nil
+}
+
+func (c Mikrotik) DeleteUser(name string) error {
+	return c.Delete(&User{Name: name})
+}
@@ -0,0 +1,58 @@
+package client
+
+import (
+	"github.com/ddelnano/terraform-provider-mikrotik/client/types"
+)
+
+// UserAaa defines /user/aaa settings, which control authentication of system users via RADIUS
+type UserAaa struct {
+	Accounting    bool                   `mikrotik:"accounting"`
+	DefaultGroup  string                 `mikrotik:"default-group"`
+	ExcludeGroups types.MikrotikList     `mikrotik:"exclude-groups"`
+	InterimUpdate types.MikrotikDuration `mikrotik:"interim-update"`
+	UseRadius     bool                   `mikrotik:"use-radius"`
+}
+
+var (
+	_ Singleton = (*UserAaa)(nil)
+	_ Resetter  = (*UserAaa)(nil)
+)
+
+func (u *UserAaa) ActionToCommand(action Action) string {
+	return map[Action]string{
+		Find:   "/user/aaa/print",
+		Update: "/user/aaa/set",
+	}[action]
+}
+
+func (u *UserAaa) DefaultValues() map[string]string {
+	return map[string]string{
+		"accounting":     "yes",
+		"default-group":  "read",
+		"exclude-groups": "",
+		"interim-update": "0s",
+		"use-radius":     "no",
+	}
+}
+
+func (client Mikrotik) FindUserAaa() (*UserAaa, error) {
+	res, err := client.FindSingleton(&UserAaa{})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*UserAaa), nil
+}
+
+func (client Mikrotik) UpdateUserAaa(u *UserAaa) (*UserAaa, error) {
+	res, err := client.UpdateSingleton(u)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*UserAaa), nil
+}
+
+func (client Mikrotik) ResetUserAaa() error {
+	return client.ResetSingleton(&UserAaa{})
+}
@@ -0,0 +1,33 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserAaa_basic(t *testing.T) {
+	c := NewClient(GetConfigFromEnv())
+
+	defer func() {
+		require.NoError(t, c.ResetUserAaa())
+
+		found, err := c.FindUserAaa()
+		require.NoError(t, err)
+		assert.False(t, found.UseRadius)
+		assert.Equal(t, "read", found.DefaultGroup)
+	}()
+
+	expected := &UserAaa{
+		Accounting:    true,
+		DefaultGroup:  "write",
+		ExcludeGroups: types.MikrotikList{"full"},
+		InterimUpdate: types.MikrotikDuration(300),
+		UseRadius:     true,
+	}
+	updated, err := c.UpdateUserAaa(expected)
+	require.NoError(t, err)
+	assert.Equal(t, expected, updated)
+}
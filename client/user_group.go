@@ -0,0 +1,105 @@
+package client
+
+import (
+	"strings"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client/types"
+	"github.com/go-routeros/routeros"
+)
+
+// UserGroup defines group of system users in /user/group menu
+//
+// RouterOS reports all known policies, prefixing the ones not granted to the group with '!'.
+// Only granted policies are kept in Policy field.
+type UserGroup struct {
+	Id      string             `mikrotik:".id" codegen:"id,mikrotikID"`
+	Name    string             `mikrotik:"name" codegen:"name,terraformID,required"`
+	Comment string             `mikrotik:"comment" codegen:"comment"`
+	Policy  types.MikrotikList `mikrotik:"policy" codegen:"policy,required"`
+	Skin    string             `mikrotik:"skin" codegen:"skin"`
+}
+
+var _ Resource = (*UserGroup)(nil)
+
+func (b *UserGroup) ActionToCommand(a Action) string {
+	return map[Action]string{
+		Add:    "/user/group/add",
+		Find:   "/user/group/print",
+		Update: "/user/group/set",
+		Delete: "/user/group/remove",
+	}[a]
+}
+
+func (b *UserGroup) IDField() string {
+	return ".id"
+}
+
+func (b *UserGroup) ID() string {
+	return b.Id
+}
+
+func (b *UserGroup) SetID(id string) {
+	b.Id = id
+}
+
+func (b *UserGroup) AfterAddHook(r *routeros.Reply) {
+	b.Id = r.Done.Map["ret"]
+}
+
+func (b *UserGroup) Normalize(r *routeros.Reply) {
+	granted := types.MikrotikList{}
+	for _, p := range b.Policy {
+		if !strings.HasPrefix(p, "!") {
+			granted = append(granted, p)
+		}
+	}
+	b.Policy = granted
+}
+
+func (b *UserGroup) FindField() string {
+	return "name"
+}
+
+func (b *UserGroup) FindFieldValue() string {
+	return b.Name
+}
+
+func (b *UserGroup) DeleteField() string {
+	return "numbers"
+}
+
+func (b *UserGroup) DeleteFieldValue() string {
+	return b.Name
+}
+
+// Typed wrappers
+func (c Mikrotik) AddUserGroup(r *UserGroup) (*UserGroup, error) {
+	res, err := c.Add(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*UserGroup), nil
+}
+
+func (c Mikrotik) UpdateUserGroup(r *UserGroup) (*UserGroup, error) {
+	res, err := c.Update(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*UserGroup), nil
+}
+
+func (c Mikrotik) FindUserGroup(name string) (*UserGroup, error) {
+	res, err := c.Find(&UserGroup{Name: name})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*UserGroup), nil
+}
+
+func (c Mikrotik) DeleteUserGroup(name string) error {
+	return c.Delete(&UserGroup{Name: name})
+}
@@ -0,0 +1,42 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserGroup_basic(t *testing.T) {
+	c := NewClient(GetConfigFromEnv())
+
+	group := &UserGroup{
+		Name:    "test-group-" + RandomString(),
+		Comment: "Test group",
+		Policy:  types.MikrotikList{"read", "test", "winbox"},
+	}
+
+	created, err := c.AddUserGroup(group)
+	require.NoError(t, err)
+	defer func(name string) {
+		assert.NoError(t, c.DeleteUserGroup(name))
+	}(created.Name)
+
+	found, err := c.FindUserGroup(group.Name)
+	require.NoError(t, err)
+	assert.Equal(t, created.Id, found.Id)
+	assert.ElementsMatch(t, group.Policy, found.Policy)
+
+	group.Id = created.Id
+	group.Policy = types.MikrotikList{"read", "ssh"}
+	updated, err := c.UpdateUserGroup(group)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, group.Policy, updated.Policy)
+}
+
+func TestUserGroup_Normalize(t *testing.T) {
+	group := &UserGroup{Policy: types.MikrotikList{"local", "!telnet", "ssh", "!ftp", "read"}}
+	group.Normalize(nil)
+	assert.Equal(t, types.MikrotikList{"local", "ssh", "read"}, group.Policy)
+}
@@ -0,0 +1,66 @@
+package client
+
+import (
+	"github.com/go-routeros/routeros"
+)
+
+// UserSshKey defines public SSH key of a system user in /user/ssh-keys menu
+//
+// Keys are added from a string, which is supported by RouterOS v7.7+.
+// RouterOS does not report the key back and keys cannot be modified once added.
+type UserSshKey struct {
+	Id       string `mikrotik:".id" codegen:"id,mikrotikID"`
+	Bits     int    `mikrotik:"bits,readonly" codegen:"bits,computed"`
+	Key      string `mikrotik:"key" codegen:"key,required"`
+	KeyOwner string `mikrotik:"key-owner,readonly" codegen:"key_owner,computed"`
+	User     string `mikrotik:"user" codegen:"user,required"`
+}
+
+var _ Resource = (*UserSshKey)(nil)
+
+func (b *UserSshKey) ActionToCommand(a Action) string {
+	return map[Action]string{
+		Add:    "/user/ssh-keys/add",
+		Find:   "/user/ssh-keys/print",
+		Delete: "/user/ssh-keys/remove",
+	}[a]
+}
+
+func (b *UserSshKey) IDField() string {
+	return ".id"
+}
+
+func (b *UserSshKey) ID() string {
+	return b.Id
+}
+
+func (b *UserSshKey) SetID(id string) {
+	b.Id = id
+}
+
+func (b *UserSshKey) AfterAddHook(r *routeros.Reply) {
+	b.Id = r.Done.Map["ret"]
+}
+
+// Typed wrappers
+func (c Mikrotik) AddUserSshKey(r *UserSshKey) (*UserSshKey, error) {
+	res, err := c.Add(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*UserSshKey), nil
+}
+
+func (c Mikrotik) FindUserSshKey(id string) (*UserSshKey, error) {
+	res, err := c.Find(&UserSshKey{Id: id})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*UserSshKey), nil
+}
+
+func (c Mikrotik) DeleteUserSshKey(id string) error {
+	return c.Delete(&UserSshKey{Id: id})
+}
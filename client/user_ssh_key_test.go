@@ -0,0 +1,33 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testUserSshPublicKey = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQDnoaswnny9g0N3oWRcS91aXDKLm8XuRPZpq2VBPf1PH9aapPbyHo0Xz7oYzs0oh1yfY6kEpNLMhvZWYWD7pJs+XruKcSQFHXBUnyZGjV3iwy2Xs/bPfOyqCQBoe4wfqEEnTxMwJv51zUi70XW8aWztWT/6scu77dKSVFc6jPFsGmUxB26gzYxjArgu0uBIkTSMFUcd/n6u3+YshNIXp56jLGt6glkePt7S9CmirUTgUIaZMC83tZrbJCBt8JfMdWwUPvjhH88H+U+NL52a30sU6f+wGYmiu84UWgri5kgxk80tTf/M00sRBxzz9X/8sj4C3iVJMTdkamKb54Fla+iV testacc@example.com"
+
+func TestUserSshKey_basic(t *testing.T) {
+	SkipIfRouterOSV6OrEarlier(t, sysResources)
+	c := NewClient(GetConfigFromEnv())
+
+	user, err := c.AddUser(&User{Name: "test-ssh-" + RandomString(), Group: "read"})
+	require.NoError(t, err)
+	defer func(name string) {
+		assert.NoError(t, c.DeleteUser(name))
+	}(user.Name)
+
+	created, err := c.AddUserSshKey(&UserSshKey{User: user.Name, Key: testUserSshPublicKey})
+	require.NoError(t, err)
+	defer func(id string) {
+		assert.NoError(t, c.DeleteUserSshKey(id))
+	}(created.Id)
+
+	found, err := c.FindUserSshKey(created.Id)
+	require.NoError(t, err)
+	assert.Equal(t, user.Name, found.User)
+	assert.Equal(t, 2048, found.Bits)
+	assert.Equal(t, "testacc@example.com", found.KeyOwner)
+}
@@ -0,0 +1,42 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUser_basic(t *testing.T) {
+	c := NewClient(GetConfigFromEnv())
+
+	user := &User{
+		Name:     "test-user-" + RandomString(),
+		Address:  types.MikrotikList{"192.168.88.0/24"},
+		Comment:  "Test user",
+		Group:    "read",
+		Password: "Secret-" + RandomString(),
+	}
+
+	created, err := c.AddUser(user)
+	require.NoError(t, err)
+	defer func(name string) {
+		assert.NoError(t, c.DeleteUser(name))
+	}(created.Name)
+
+	assert.Empty(t, created.Password, "password must not be reported back")
+
+	found, err := c.FindUser(user.Name)
+	require.NoError(t, err)
+	assert.Equal(t, created.Id, found.Id)
+	assert.Equal(t, user.Group, found.Group)
+	assert.Equal(t, user.Address, found.Address)
+
+	user.Id = created.Id
+	user.Group = "write"
+	user.Password = ""
+	updated, err := c.UpdateUser(user)
+	require.NoError(t, err)
+	assert.Equal(t, "write", updated.Group)
+}
@@ -0,0 +1,90 @@
+package client
+
+import (
+	"github.com/ddelnano/terraform-provider-mikrotik/client/types"
+	"github.com/go-routeros/routeros"
+)
+
+// Vrf defines virtual routing and forwarding instance in /ip/vrf menu, only supported by RouterOS v7+
+type Vrf struct {
+	Id         string             `mikrotik:".id" codegen:"id,mikrotikID"`
+	Comment    string             `mikrotik:"comment" codegen:"comment"`
+	Disabled   bool               `mikrotik:"disabled" codegen:"disabled"`
+	Interfaces types.MikrotikList `mikrotik:"interfaces" codegen:"interfaces,required"`
+	Name       string             `mikrotik:"name" codegen:"name,terraformID,required"`
+}
+
+var _ Resource = (*Vrf)(nil)
+
+func (b *Vrf) ActionToCommand(a Action) string {
+	return map[Action]string{
+		Add:    "/ip/vrf/add",
+		Find:   "/ip/vrf/print",
+		Update: "/ip/vrf/set",
+		Delete: "/ip/vrf/remove",
+	}[a]
+}
+
+func (b *Vrf) IDField() string {
+	return ".id"
+}
+
+func (b *Vrf) ID() string {
+	return b.Id
+}
+
+func (b *Vrf) SetID(id string) {
+	b.Id = id
+}
+
+func (b *Vrf) AfterAddHook(r *routeros.Reply) {
+	b.Id = r.Done.Map["ret"]
+}
+
+func (b *Vrf) FindField() string {
+	return "name"
+}
+
+func (b *Vrf) FindFieldValue() string {
+	return b.Name
+}
+
+func (b *Vrf) DeleteField() string {
+	return "numbers"
+}
+
+func (b *Vrf) DeleteFieldValue() string {
+	return b.Name
+}
+
+// Typed wrappers
+func (c Mikrotik) AddVrf(r *Vrf) (*Vrf, error) {
+	res, err := c.Add(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*Vrf), nil
+}
+
+func (c Mikrotik) UpdateVrf(r *Vrf) (*Vrf, error) {
+	res, err := c.Update(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*Vrf), nil
+}
+
+func (c Mikrotik) FindVrf(name string) (*Vrf, error) {
+	res, err := c.Find(&Vrf{Name: name})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*Vrf), nil
+}
+
+func (c Mikrotik) DeleteVrf(name string) error {
+	return c.Delete(&Vrf{Name: name})
+}
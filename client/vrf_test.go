@@ -0,0 +1,46 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVrf_basic(t *testing.T) {
+	SkipIfRouterOSV6OrEarlier(t, sysResources)
+	c := NewClient(GetConfigFromEnv())
+
+	var bridges []string
+	for i := 0; i < 2; i++ {
+		bridge, err := c.AddBridge(&Bridge{Name: "vrf-br-" + RandomString()})
+		require.NoError(t, err)
+		defer func(name string) {
+			assert.NoError(t, c.DeleteBridge(name))
+		}(bridge.Name)
+		bridges = append(bridges, bridge.Name)
+	}
+
+	vrf := &Vrf{
+		Name:       "test-vrf-" + RandomString(),
+		Comment:    "Test VRF",
+		Interfaces: types.MikrotikList{bridges[0]},
+	}
+
+	created, err := c.AddVrf(vrf)
+	require.NoError(t, err)
+	defer func(name string) {
+		assert.NoError(t, c.DeleteVrf(name))
+	}(created.Name)
+
+	vrf.Id = created.Id
+	found, err := c.FindVrf(vrf.Name)
+	require.NoError(t, err)
+	assert.Equal(t, vrf, found)
+
+	vrf.Interfaces = types.MikrotikList{bridges[0], bridges[1]}
+	updated, err := c.UpdateVrf(vrf)
+	require.NoError(t, err)
+	assert.Equal(t, vrf, updated)
+}
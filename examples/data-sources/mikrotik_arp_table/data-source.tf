@@ -0,0 +1,5 @@
+data "mikrotik_arp_table" "all" {}
+
+output "hosts_on_bridge" {
+  value = [for e in data.mikrotik_arp_table.all.entries : e.address if e.interface == "bridge"]
+}
@@ -0,0 +1,8 @@
+data "mikrotik_bgp_sessions" "all" {}
+
+check "bgp_sessions_established" {
+  assert {
+    condition     = alltrue([for s in data.mikrotik_bgp_sessions.all.sessions : s.established])
+    error_message = "Not all BGP sessions are established."
+  }
+}
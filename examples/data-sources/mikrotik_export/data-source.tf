@@ -0,0 +1,9 @@
+data "mikrotik_export" "firewall" {
+  path    = "/ip/firewall"
+  compact = true
+}
+
+resource "local_file" "firewall_snapshot" {
+  filename = "${path.module}/snapshots/firewall.rsc"
+  content  = data.mikrotik_export.firewall.content
+}
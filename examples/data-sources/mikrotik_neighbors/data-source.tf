@@ -0,0 +1,5 @@
+data "mikrotik_neighbors" "all" {}
+
+output "neighbor_identities" {
+  value = { for n in data.mikrotik_neighbors.all.neighbors : n.interface => n.identity }
+}
@@ -0,0 +1,2 @@
+# The ID is always the menu path of the settings.
+terraform import mikrotik_bandwidth_server.settings /tool/bandwidth-server
@@ -0,0 +1,3 @@
+resource "mikrotik_bandwidth_server" "settings" {
+  enabled = false
+}
@@ -0,0 +1 @@
+terraform import mikrotik_bgp_connection.isp1 isp1
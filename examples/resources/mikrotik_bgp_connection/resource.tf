@@ -0,0 +1,25 @@
+resource "mikrotik_bgp_template" "transit" {
+  name                = "transit"
+  as                  = 65530
+  input_filter        = "transit-in"
+  output_filter_chain = "transit-out"
+}
+
+resource "mikrotik_bgp_connection" "isp1" {
+  name           = "isp1"
+  as             = 65530
+  local_address  = "203.0.113.2"
+  local_role     = "ebgp"
+  remote_address = "203.0.113.1"
+  remote_as      = 64500
+  templates      = [mikrotik_bgp_template.transit.name]
+}
+
+resource "mikrotik_bgp_connection" "route_reflector" {
+  name           = "rr1"
+  as             = 65530
+  local_role     = "ibgp"
+  multihop       = true
+  remote_address = "10.255.0.1"
+  remote_as      = 65530
+}
@@ -0,0 +1 @@
+terraform import mikrotik_bgp_template.transit transit
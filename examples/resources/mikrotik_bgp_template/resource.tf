@@ -0,0 +1,10 @@
+resource "mikrotik_bgp_template" "transit" {
+  name                = "transit"
+  as                  = 65530
+  address_families    = ["ip", "ipv6"]
+  hold_time           = "3m"
+  input_filter        = "transit-in"
+  output_filter_chain = "transit-out"
+  output_redistribute = ["connected", "static"]
+  router_id           = "192.0.2.1"
+}
@@ -0,0 +1 @@
+terraform import mikrotik_certificate.webfig webfig
@@ -0,0 +1,22 @@
+resource "mikrotik_certificate" "ca" {
+  name        = "local-ca"
+  common_name = "local-ca"
+  key_usage   = ["key-cert-sign", "crl-sign"]
+  days_valid  = 3650
+  trusted     = true
+}
+
+resource "mikrotik_certificate" "webfig" {
+  name             = "webfig"
+  ca               = mikrotik_certificate.ca.name
+  common_name      = "router.example.com"
+  key_size         = "2048"
+  key_usage        = ["digital-signature", "key-encipherment", "tls-server"]
+  subject_alt_name = ["DNS:router.example.com", "IP:192.168.88.1"]
+}
+
+resource "mikrotik_certificate" "imported" {
+  name            = "imported"
+  certificate_pem = file("${path.module}/server.crt")
+  private_key_pem = file("${path.module}/server.key")
+}
@@ -0,0 +1,2 @@
+# The ID is always the menu path of the settings.
+terraform import mikrotik_dns.settings /ip/dns
@@ -0,0 +1,7 @@
+resource "mikrotik_dns" "settings" {
+  servers               = ["1.1.1.1", "1.0.0.1"]
+  allow_remote_requests = true
+  cache_size            = 4096
+  use_doh_server        = "https://cloudflare-dns.com/dns-query"
+  verify_doh_cert       = true
+}
@@ -0,0 +1 @@
+terraform import mikrotik_file.backup_script flash/scripts/backup.rsc
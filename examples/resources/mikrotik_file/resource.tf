@@ -0,0 +1,4 @@
+resource "mikrotik_file" "backup_script" {
+  name     = "flash/scripts/backup.rsc"
+  contents = file("${path.module}/backup.rsc")
+}
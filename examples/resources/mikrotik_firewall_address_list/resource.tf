@@ -0,0 +1,11 @@
+resource "mikrotik_firewall_address_list" "office" {
+  list    = "trusted"
+  address = "203.0.113.0/24"
+  comment = "Office network"
+}
+
+resource "mikrotik_firewall_address_list" "temporary_ban" {
+  list    = "blacklist"
+  address = "198.51.100.7"
+  timeout = 86400
+}
@@ -0,0 +1,2 @@
+# The ID is the name of the address list.
+terraform import mikrotik_firewall_address_list_bulk.blocklist blocklist
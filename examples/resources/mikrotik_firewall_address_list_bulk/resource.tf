@@ -0,0 +1,5 @@
+resource "mikrotik_firewall_address_list_bulk" "blocklist" {
+  list      = "blocklist"
+  comment   = "Managed by Terraform"
+  addresses = split("\n", trimspace(file("${path.module}/blocklist.txt")))
+}
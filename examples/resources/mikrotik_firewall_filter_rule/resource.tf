@@ -9,3 +9,24 @@ resource "mikrotik_firewall_filter_rule" "https" {
   out_interface_list = "ether3"
   protocol           = "tcp"
 }
+
+resource "mikrotik_firewall_filter_rule" "drop_ssh_from_untrusted" {
+  action           = "drop"
+  chain            = "input"
+  comment          = "Drop SSH from everyone except trusted hosts"
+  dst_port         = "22"
+  protocol         = "tcp"
+  src_address_list = "!trusted"
+  log              = true
+  log_prefix       = "ssh-drop"
+}
+
+resource "mikrotik_firewall_filter_rule" "allow_ssh_from_trusted" {
+  action           = "accept"
+  chain            = "input"
+  comment          = "Allow SSH from trusted hosts"
+  dst_port         = "22"
+  protocol         = "tcp"
+  src_address_list = "trusted"
+  place_before     = mikrotik_firewall_filter_rule.drop_ssh_from_untrusted.id
+}
@@ -0,0 +1,3 @@
+# The ID is the chain name, optionally followed by colon and the comment prefix.
+terraform import mikrotik_firewall_filter_ruleset.input input
+terraform import mikrotik_firewall_filter_ruleset.managed forward:tf-
@@ -0,0 +1,22 @@
+resource "mikrotik_firewall_filter_ruleset" "input" {
+  chain = "input"
+
+  rule {
+    action           = "accept"
+    comment          = "Allow established connections"
+    connection_state = ["established", "related"]
+  }
+
+  rule {
+    action           = "accept"
+    comment          = "Allow SSH from trusted hosts"
+    protocol         = "tcp"
+    dst_port         = "22"
+    src_address_list = "trusted"
+  }
+
+  rule {
+    action  = "drop"
+    comment = "Drop everything else"
+  }
+}
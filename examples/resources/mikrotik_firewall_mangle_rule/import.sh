@@ -0,0 +1 @@
+terraform import mikrotik_firewall_mangle_rule.isp2_routing '*5'
@@ -0,0 +1,26 @@
+resource "mikrotik_firewall_mangle_rule" "isp2_connections" {
+  action              = "mark-connection"
+  chain               = "prerouting"
+  comment             = "Mark new connections from ISP2 subscribers"
+  connection_state    = ["new"]
+  new_connection_mark = "isp2"
+  src_address_list    = "via-isp2"
+}
+
+resource "mikrotik_firewall_mangle_rule" "isp2_routing" {
+  action           = "mark-routing"
+  chain            = "prerouting"
+  comment          = "Route ISP2 connections via ISP2 routing table"
+  connection_mark  = "isp2"
+  new_routing_mark = "isp2"
+  passthrough      = false
+}
+
+resource "mikrotik_firewall_mangle_rule" "clamp_mss" {
+  action        = "change-mss"
+  chain         = "forward"
+  new_mss       = "clamp-to-pmtu"
+  out_interface = "pppoe-out1"
+  protocol      = "tcp"
+  tcp_flags     = ["syn"]
+}
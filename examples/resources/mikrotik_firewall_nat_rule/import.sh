@@ -0,0 +1 @@
+terraform import mikrotik_firewall_nat_rule.web_server '*3'
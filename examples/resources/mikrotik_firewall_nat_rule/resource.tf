@@ -0,0 +1,26 @@
+resource "mikrotik_firewall_nat_rule" "masquerade" {
+  action             = "masquerade"
+  chain              = "srcnat"
+  comment            = "Masquerade LAN traffic"
+  out_interface_list = "WAN"
+  src_address_list   = "local"
+}
+
+resource "mikrotik_firewall_nat_rule" "web_server" {
+  action            = "dst-nat"
+  chain             = "dstnat"
+  comment           = "Forward HTTPS to the web server"
+  dst_port          = "443"
+  in_interface_list = "WAN"
+  protocol          = "tcp"
+  to_addresses      = "192.168.88.10"
+  to_ports          = "443"
+}
+
+resource "mikrotik_firewall_nat_rule" "vpn_bypass" {
+  action           = "accept"
+  chain            = "srcnat"
+  comment          = "Do not masquerade VPN traffic"
+  dst_address_list = "vpn"
+  place_before     = mikrotik_firewall_nat_rule.masquerade.id
+}
@@ -0,0 +1 @@
+terraform import mikrotik_firewall_raw_rule.drop_blacklisted '*2'
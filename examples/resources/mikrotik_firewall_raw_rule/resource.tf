@@ -0,0 +1,14 @@
+resource "mikrotik_firewall_raw_rule" "drop_blacklisted" {
+  action           = "drop"
+  chain            = "prerouting"
+  comment          = "Drop traffic from blacklisted sources before connection tracking"
+  in_interface     = "ether1"
+  src_address_list = "blacklist"
+}
+
+resource "mikrotik_firewall_raw_rule" "notrack_backup" {
+  action           = "notrack"
+  chain            = "prerouting"
+  comment          = "Do not track bulk backup traffic"
+  dst_address_list = "backup-servers"
+}
@@ -0,0 +1 @@
+terraform import mikrotik_ip_route.default '*80000001'
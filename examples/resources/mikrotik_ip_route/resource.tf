@@ -0,0 +1,19 @@
+resource "mikrotik_ip_route" "default" {
+  dst_address = "0.0.0.0/0"
+  gateway     = "203.0.113.1"
+  distance    = 1
+  comment     = "Default route via ISP1"
+}
+
+resource "mikrotik_ip_route" "backup" {
+  dst_address   = "0.0.0.0/0"
+  gateway       = "pppoe-out1"
+  distance      = 2
+  check_gateway = "ping"
+  comment       = "Backup default route via ISP2"
+}
+
+resource "mikrotik_ip_route" "bogons" {
+  dst_address = "198.18.0.0/15"
+  blackhole   = true
+}
@@ -0,0 +1 @@
+terraform import mikrotik_ip_service.ssh ssh
@@ -0,0 +1,10 @@
+resource "mikrotik_ip_service" "telnet" {
+  name     = "telnet"
+  disabled = true
+}
+
+resource "mikrotik_ip_service" "ssh" {
+  name    = "ssh"
+  port    = 2222
+  address = ["10.0.0.0/8", "192.168.88.0/24"]
+}
@@ -0,0 +1 @@
+terraform import mikrotik_ipv6_firewall_address_list.office '*4'
@@ -0,0 +1,11 @@
+resource "mikrotik_ipv6_firewall_address_list" "office" {
+  list    = "trusted"
+  address = "2001:db8:100::/48"
+  comment = "Office network"
+}
+
+resource "mikrotik_ipv6_firewall_address_list" "temporary_ban" {
+  list    = "blacklist"
+  address = "2001:db8:bad::7/128"
+  timeout = 86400
+}
@@ -0,0 +1 @@
+terraform import mikrotik_ipv6_firewall_filter_rule.icmpv6 '*7'
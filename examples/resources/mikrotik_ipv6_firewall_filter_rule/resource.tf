@@ -0,0 +1,21 @@
+resource "mikrotik_ipv6_firewall_filter_rule" "icmpv6" {
+  action   = "accept"
+  chain    = "input"
+  comment  = "Accept ICMPv6"
+  protocol = "icmpv6"
+}
+
+resource "mikrotik_ipv6_firewall_filter_rule" "drop_from_wan" {
+  action            = "drop"
+  chain             = "input"
+  comment           = "Drop everything else not coming from LAN"
+  in_interface_list = "!LAN"
+}
+
+resource "mikrotik_ipv6_firewall_filter_rule" "established" {
+  action           = "accept"
+  chain            = "input"
+  comment          = "Accept established and related"
+  connection_state = ["established", "related"]
+  place_before     = mikrotik_ipv6_firewall_filter_rule.drop_from_wan.id
+}
@@ -0,0 +1 @@
+terraform import mikrotik_ipv6_firewall_mangle_rule.clamp_mss '*3'
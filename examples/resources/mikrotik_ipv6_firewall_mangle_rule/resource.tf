@@ -0,0 +1,8 @@
+resource "mikrotik_ipv6_firewall_mangle_rule" "clamp_mss" {
+  action        = "change-mss"
+  chain         = "forward"
+  new_mss       = "clamp-to-pmtu"
+  out_interface = "pppoe-out1"
+  protocol      = "tcp"
+  tcp_flags     = ["syn"]
+}
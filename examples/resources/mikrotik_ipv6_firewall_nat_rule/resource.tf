@@ -0,0 +1,10 @@
+resource "mikrotik_ipv6_firewall_nat_rule" "web_server" {
+  action            = "dst-nat"
+  chain             = "dstnat"
+  comment           = "Forward HTTPS to the web server"
+  dst_port          = "443"
+  in_interface_list = "WAN"
+  protocol          = "tcp"
+  to_address        = "fd00::10/128"
+  to_ports          = "443"
+}
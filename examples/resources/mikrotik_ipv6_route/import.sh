@@ -0,0 +1 @@
+terraform import mikrotik_ipv6_route.default '*30000001'
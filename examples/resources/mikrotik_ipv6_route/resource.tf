@@ -0,0 +1,5 @@
+resource "mikrotik_ipv6_route" "default" {
+  dst_address = "::/0"
+  gateway     = "fe80::1%ether1"
+  comment     = "Default route via upstream router"
+}
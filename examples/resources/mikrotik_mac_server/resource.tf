@@ -0,0 +1,3 @@
+resource "mikrotik_mac_server" "settings" {
+  allowed_interface_list = "LAN"
+}
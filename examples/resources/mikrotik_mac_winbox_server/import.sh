@@ -0,0 +1,2 @@
+# The ID is always the menu path of the settings.
+terraform import mikrotik_mac_winbox_server.settings /tool/mac-server/mac-winbox
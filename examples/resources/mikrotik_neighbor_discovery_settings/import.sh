@@ -0,0 +1,2 @@
+# The ID is always the menu path of the settings.
+terraform import mikrotik_neighbor_discovery_settings.settings /ip/neighbor/discovery-settings
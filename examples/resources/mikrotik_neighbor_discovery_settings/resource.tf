@@ -0,0 +1,3 @@
+resource "mikrotik_neighbor_discovery_settings" "settings" {
+  discover_interface_list = "LAN"
+}
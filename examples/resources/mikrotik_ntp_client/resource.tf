@@ -0,0 +1,12 @@
+# RouterOS v7
+resource "mikrotik_ntp_client" "ntp" {
+  enabled = true
+  servers = ["0.pool.ntp.org", "1.pool.ntp.org"]
+}
+
+# RouterOS v6
+resource "mikrotik_ntp_client" "ntp_legacy" {
+  enabled       = true
+  primary_ntp   = "162.159.200.1"
+  secondary_ntp = "162.159.200.123"
+}
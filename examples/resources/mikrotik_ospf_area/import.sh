@@ -0,0 +1 @@
+terraform import mikrotik_ospf_area.backbone backbone-v2
@@ -0,0 +1,17 @@
+resource "mikrotik_ospf_instance" "default_v2" {
+  name      = "default-v2"
+  router_id = "10.255.0.1"
+}
+
+resource "mikrotik_ospf_area" "backbone" {
+  name     = "backbone-v2"
+  instance = mikrotik_ospf_instance.default_v2.name
+}
+
+resource "mikrotik_ospf_area" "campus" {
+  name         = "campus"
+  area_id      = "0.0.0.10"
+  instance     = mikrotik_ospf_instance.default_v2.name
+  type         = "stub"
+  no_summaries = true
+}
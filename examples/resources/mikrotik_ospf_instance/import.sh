@@ -0,0 +1 @@
+terraform import mikrotik_ospf_instance.default_v2 default-v2
@@ -0,0 +1,12 @@
+resource "mikrotik_ospf_instance" "default_v2" {
+  name              = "default-v2"
+  originate_default = "if-installed"
+  redistribute      = ["connected", "static"]
+  router_id         = "10.255.0.1"
+}
+
+resource "mikrotik_ospf_instance" "default_v3" {
+  name      = "default-v3"
+  router_id = "10.255.0.1"
+  version   = 3
+}
@@ -0,0 +1 @@
+terraform import mikrotik_ospf_interface_template.core_links '*1'
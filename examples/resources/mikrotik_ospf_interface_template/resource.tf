@@ -0,0 +1,25 @@
+resource "mikrotik_ospf_instance" "default_v2" {
+  name      = "default-v2"
+  router_id = "10.255.0.1"
+}
+
+resource "mikrotik_ospf_area" "backbone" {
+  name     = "backbone-v2"
+  instance = mikrotik_ospf_instance.default_v2.name
+}
+
+resource "mikrotik_ospf_interface_template" "core_links" {
+  area       = mikrotik_ospf_area.backbone.name
+  interfaces = ["sfp-sfpplus1", "sfp-sfpplus2"]
+  type       = "ptp"
+  cost       = 10
+  auth       = "sha256"
+  auth_id    = 1
+  auth_key   = "change-me"
+}
+
+resource "mikrotik_ospf_interface_template" "user_networks" {
+  area     = mikrotik_ospf_area.backbone.name
+  networks = ["10.10.0.0/16"]
+  passive  = true
+}
@@ -0,0 +1,5 @@
+resource "mikrotik_ppp_aaa" "settings" {
+  use_radius     = true
+  accounting     = true
+  interim_update = 300
+}
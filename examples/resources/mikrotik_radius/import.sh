@@ -0,0 +1 @@
+terraform import mikrotik_radius.aaa '*1'
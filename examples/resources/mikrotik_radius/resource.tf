@@ -0,0 +1,7 @@
+resource "mikrotik_radius" "aaa" {
+  address     = "192.168.88.20"
+  secret      = "RadiusSecret-123"
+  service     = ["login", "ppp", "hotspot"]
+  src_address = "192.168.88.1"
+  timeout     = "1s"
+}
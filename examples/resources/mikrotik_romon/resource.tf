@@ -0,0 +1,4 @@
+resource "mikrotik_romon" "settings" {
+  enabled = true
+  secrets = ["RomonSecret-123"]
+}
@@ -0,0 +1 @@
+terraform import mikrotik_routing_filter_chain.transit_in transit-in
@@ -0,0 +1,25 @@
+resource "mikrotik_routing_filter_chain" "transit_in" {
+  chain = "transit-in"
+
+  rule {
+    comment = "Do not accept default route from transit"
+    rule    = "if (dst == 0.0.0.0/0) { reject; }"
+  }
+
+  rule {
+    rule = "if (dst-len > 24) { reject; }"
+  }
+
+  rule {
+    rule = "accept;"
+  }
+}
+
+resource "mikrotik_bgp_connection" "isp1" {
+  name           = "isp1"
+  as             = 65530
+  input_filter   = mikrotik_routing_filter_chain.transit_in.chain
+  local_role     = "ebgp"
+  remote_address = "203.0.113.1"
+  remote_as      = 64500
+}
@@ -0,0 +1 @@
+terraform import mikrotik_routing_filter_rule.reject_default '*3'
@@ -0,0 +1,16 @@
+resource "mikrotik_routing_filter_rule" "reject_default" {
+  chain   = "transit-in"
+  comment = "Do not accept default route from transit"
+  rule    = "if (dst == 0.0.0.0/0) { reject; }"
+}
+
+resource "mikrotik_routing_filter_rule" "accept_rest" {
+  chain = "transit-in"
+  rule  = "accept;"
+}
+
+resource "mikrotik_routing_filter_rule" "reject_bogons" {
+  chain        = "transit-in"
+  rule         = "if (dst in 10.0.0.0/8 || dst in 172.16.0.0/12 || dst in 192.168.0.0/16) { reject; }"
+  place_before = mikrotik_routing_filter_rule.accept_rest.id
+}
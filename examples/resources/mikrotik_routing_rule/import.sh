@@ -0,0 +1 @@
+terraform import mikrotik_routing_rule.guests_via_isp2 '*1'
@@ -0,0 +1,24 @@
+resource "mikrotik_routing_table" "isp2" {
+  name = "isp2"
+}
+
+resource "mikrotik_routing_rule" "guests_via_isp2" {
+  action      = "lookup-only-in-table"
+  comment     = "Route guest network via ISP2"
+  src_address = "192.168.100.0/24"
+  table       = mikrotik_routing_table.isp2.name
+}
+
+resource "mikrotik_routing_rule" "marked_via_isp2" {
+  action       = "lookup"
+  routing_mark = "isp2"
+  table        = mikrotik_routing_table.isp2.name
+}
+
+resource "mikrotik_routing_rule" "local_traffic" {
+  action       = "lookup-only-in-table"
+  comment      = "Keep traffic between local networks in the main table"
+  dst_address  = "192.168.0.0/16"
+  table        = "main"
+  place_before = mikrotik_routing_rule.guests_via_isp2.id
+}
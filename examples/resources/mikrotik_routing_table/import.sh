@@ -0,0 +1 @@
+terraform import mikrotik_routing_table.isp2 isp2
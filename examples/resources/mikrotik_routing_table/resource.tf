@@ -0,0 +1,10 @@
+resource "mikrotik_routing_table" "isp2" {
+  name    = "isp2"
+  comment = "Routes via the second uplink"
+}
+
+resource "mikrotik_ip_route" "isp2_default" {
+  dst_address   = "0.0.0.0/0"
+  gateway       = "198.51.100.1"
+  routing_table = mikrotik_routing_table.isp2.name
+}
@@ -0,0 +1,5 @@
+resource "mikrotik_ssh_server" "settings" {
+  strong_crypto      = true
+  forwarding_enabled = "no"
+  host_key_type      = "ed25519"
+}
@@ -0,0 +1,4 @@
+resource "mikrotik_system_clock" "clock" {
+  time_zone_name       = "Europe/Riga"
+  time_zone_autodetect = false
+}
@@ -0,0 +1,3 @@
+resource "mikrotik_system_identity" "identity" {
+  name = "core-router-01"
+}
@@ -0,0 +1 @@
+terraform import mikrotik_user.jdoe jdoe
@@ -0,0 +1,7 @@
+resource "mikrotik_user" "jdoe" {
+  name     = "jdoe"
+  group    = mikrotik_user_group.noc.name
+  password = "ChangeMe-123"
+  address  = ["10.0.0.0/8"]
+  comment  = "John Doe"
+}
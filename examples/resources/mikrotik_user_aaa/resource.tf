@@ -0,0 +1,6 @@
+resource "mikrotik_user_aaa" "settings" {
+  use_radius     = true
+  default_group  = "read"
+  exclude_groups = ["full"]
+  interim_update = 300
+}
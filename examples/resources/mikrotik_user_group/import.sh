@@ -0,0 +1 @@
+terraform import mikrotik_user_group.noc noc
@@ -0,0 +1,5 @@
+resource "mikrotik_user_group" "noc" {
+  name    = "noc"
+  comment = "Network operations"
+  policy  = ["local", "ssh", "read", "test", "winbox", "web", "api"]
+}
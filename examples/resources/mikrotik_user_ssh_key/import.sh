@@ -0,0 +1,4 @@
+# The ID is the key ID, optionally followed by colon and the public key.
+# RouterOS does not report the key back, so without it the key is replaced on the next apply.
+terraform import mikrotik_user_ssh_key.jdoe '*1'
+terraform import mikrotik_user_ssh_key.jdoe '*1:ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQ... jdoe@example.com'
@@ -0,0 +1,4 @@
+resource "mikrotik_user_ssh_key" "jdoe" {
+  user = mikrotik_user.jdoe.name
+  key  = file("~/.ssh/id_rsa.pub")
+}
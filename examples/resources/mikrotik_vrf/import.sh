@@ -0,0 +1 @@
+terraform import mikrotik_vrf.customer_a customer-a
@@ -0,0 +1,24 @@
+resource "mikrotik_bridge" "customer_a" {
+  name = "customer-a"
+}
+
+resource "mikrotik_vrf" "customer_a" {
+  name       = "customer-a"
+  interfaces = [mikrotik_bridge.customer_a.name]
+}
+
+resource "mikrotik_ip_route" "customer_a_default" {
+  dst_address   = "0.0.0.0/0"
+  gateway       = "192.0.2.1"
+  routing_table = mikrotik_vrf.customer_a.name
+}
+
+resource "mikrotik_bgp_connection" "customer_a" {
+  name           = "customer-a"
+  as             = 65530
+  local_role     = "ebgp"
+  remote_address = "192.0.2.1"
+  remote_as      = 65533
+  vrf            = mikrotik_vrf.customer_a.name
+  routing_table  = mikrotik_vrf.customer_a.name
+}
@@ -0,0 +1,111 @@
+package mikrotik
+
+import (
+	"context"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/ddelnano/terraform-provider-mikrotik/mikrotik/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type arpTable struct {
+	client *client.Mikrotik
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &arpTable{}
+	_ datasource.DataSourceWithConfigure = &arpTable{}
+)
+
+// NewArpTableDataSource is a helper function to simplify the provider implementation.
+func NewArpTableDataSource() datasource.DataSource {
+	return &arpTable{}
+}
+
+func (d *arpTable) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*client.Mikrotik)
+}
+
+// Metadata returns the data source type name.
+func (d *arpTable) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_arp_table"
+}
+
+// Schema defines the schema for the data source.
+func (d *arpTable) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists entries of the ARP table on the MikroTik device.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Identifier of this data source.",
+			},
+			"entries": schema.ListAttribute{
+				Computed: true,
+				ElementType: tftypes.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"id":          tftypes.StringType,
+						"address":     tftypes.StringType,
+						"mac_address": tftypes.StringType,
+						"interface":   tftypes.StringType,
+						"comment":     tftypes.StringType,
+						"complete":    tftypes.BoolType,
+						"disabled":    tftypes.BoolType,
+						"dynamic":     tftypes.BoolType,
+						"invalid":     tftypes.BoolType,
+					},
+				},
+				Description: "List of ARP entries. Each item contains IP `address`, `mac_address`, the local `interface` " +
+					"the host is reachable through, `comment` and `complete`, `disabled`, `dynamic`, `invalid` flags.",
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *arpTable) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state arpTableModel
+
+	records, err := d.client.ListIpArp()
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading ARP table", err.Error())
+		return
+	}
+
+	state.Id = tftypes.StringValue("arp_table")
+	state.Entries = make([]arpEntryModel, len(records))
+	for i := range records {
+		if err := utils.MikrotikStructToTerraformModel(ctx, &records[i], &state.Entries[i]); err != nil {
+			resp.Diagnostics.AddError("Cannot copy model: MikroTik -> Terraform", err.Error())
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+type arpTableModel struct {
+	Id      tftypes.String  `tfsdk:"id"`
+	Entries []arpEntryModel `tfsdk:"entries"`
+}
+
+type arpEntryModel struct {
+	Id         tftypes.String `tfsdk:"id"`
+	Address    tftypes.String `tfsdk:"address"`
+	MacAddress tftypes.String `tfsdk:"mac_address"`
+	Interface  tftypes.String `tfsdk:"interface"`
+	Comment    tftypes.String `tfsdk:"comment"`
+	Complete   tftypes.Bool   `tfsdk:"complete"`
+	Disabled   tftypes.Bool   `tfsdk:"disabled"`
+	Dynamic    tftypes.Bool   `tfsdk:"dynamic"`
+	Invalid    tftypes.Bool   `tfsdk:"invalid"`
+}
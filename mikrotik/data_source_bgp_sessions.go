@@ -0,0 +1,112 @@
+package mikrotik
+
+import (
+	"context"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/ddelnano/terraform-provider-mikrotik/mikrotik/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type bgpSessions struct {
+	client *client.Mikrotik
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &bgpSessions{}
+	_ datasource.DataSourceWithConfigure = &bgpSessions{}
+)
+
+// NewBgpSessionsDataSource is a helper function to simplify the provider implementation.
+func NewBgpSessionsDataSource() datasource.DataSource {
+	return &bgpSessions{}
+}
+
+func (d *bgpSessions) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*client.Mikrotik)
+}
+
+// Metadata returns the data source type name.
+func (d *bgpSessions) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bgp_sessions"
+}
+
+// Schema defines the schema for the data source.
+func (d *bgpSessions) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists BGP sessions and their state. Uses BGP peer status on RouterOS v6 and BGP sessions on RouterOS v7+.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Identifier of this data source.",
+			},
+			"sessions": schema.ListAttribute{
+				Computed: true,
+				ElementType: tftypes.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"id":             tftypes.StringType,
+						"established":    tftypes.BoolType,
+						"name":           tftypes.StringType,
+						"prefix_count":   tftypes.Int64Type,
+						"remote_address": tftypes.StringType,
+						"remote_as":      tftypes.Int64Type,
+						"remote_id":      tftypes.StringType,
+						"state":          tftypes.StringType,
+						"uptime":         tftypes.Int64Type,
+					},
+				},
+				Description: "List of BGP sessions. Each item contains `name`, `state`, `established`, `uptime` in seconds, " +
+					"received `prefix_count`, `remote_address`, `remote_as` and `remote_id` (router ID of the remote peer). " +
+					"RouterOS v7 does not report the BGP state machine status, so `state` is either `established` or `idle` there.",
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *bgpSessions) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state bgpSessionsModel
+
+	records, err := d.client.ListBgpSessions()
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading BGP sessions", err.Error())
+		return
+	}
+
+	state.Id = tftypes.StringValue("bgp_sessions")
+	state.Sessions = make([]bgpSessionModel, len(records))
+	for i := range records {
+		if err := utils.MikrotikStructToTerraformModel(ctx, &records[i], &state.Sessions[i]); err != nil {
+			resp.Diagnostics.AddError("Cannot copy model: MikroTik -> Terraform", err.Error())
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+type bgpSessionsModel struct {
+	Id       tftypes.String    `tfsdk:"id"`
+	Sessions []bgpSessionModel `tfsdk:"sessions"`
+}
+
+type bgpSessionModel struct {
+	Id            tftypes.String `tfsdk:"id"`
+	Established   tftypes.Bool   `tfsdk:"established"`
+	Name          tftypes.String `tfsdk:"name"`
+	PrefixCount   tftypes.Int64  `tfsdk:"prefix_count"`
+	RemoteAddress tftypes.String `tfsdk:"remote_address"`
+	RemoteAs      tftypes.Int64  `tfsdk:"remote_as"`
+	RemoteId      tftypes.String `tfsdk:"remote_id"`
+	State         tftypes.String `tfsdk:"state"`
+	Uptime        tftypes.Int64  `tfsdk:"uptime"`
+}
@@ -0,0 +1,111 @@
+package mikrotik
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccBgpSessionsDataSource_connection(t *testing.T) {
+	client.SkipIfRouterOSV6OrEarlier(t, sysResources)
+	dataSourceName := "data.mikrotik_bgp_sessions.all"
+	name := acctest.RandomWithPrefix("tf-acc-session")
+	remoteAs := acctest.RandIntRange(64512, 65534)
+	connectionConfig := fmt.Sprintf(`
+		resource "mikrotik_bgp_connection" "testacc" {
+			name           = %q
+			as             = 65530
+			local_role     = "ebgp"
+			remote_address = "172.21.16.1"
+			remote_as      = %d
+		}
+	`, name, remoteAs)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBgpConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: connectionConfig,
+			},
+			{
+				// RouterOS v7 starts the session shortly after the connection is added
+				PreConfig: func() { testAccWaitForBgpSession(t, name) },
+				Config:    connectionConfig + testAccBgpSessionsDataSourceConfig("mikrotik_bgp_connection.testacc"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "sessions.*", map[string]string{
+						"remote_address": "172.21.16.1",
+						"remote_as":      strconv.Itoa(remoteAs),
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBgpSessionsDataSource_legacyPeer(t *testing.T) {
+	client.SkipIfRouterOSV7OrLater(t, sysResources)
+	dataSourceName := "data.mikrotik_bgp_sessions.all"
+	name := acctest.RandomWithPrefix("tf-acc-session")
+	remoteAs := acctest.RandIntRange(64512, 65534)
+	peerConfig := fmt.Sprintf(`
+		resource "mikrotik_bgp_peer" "testacc" {
+			name           = %q
+			instance       = "default"
+			remote_address = "172.21.16.1"
+			remote_as      = %d
+		}
+	`, name, remoteAs)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMikrotikBgpPeerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: peerConfig + testAccBgpSessionsDataSourceConfig("mikrotik_bgp_peer.testacc"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "sessions.*", map[string]string{
+						"name":           name,
+						"remote_address": "172.21.16.1",
+						"remote_as":      strconv.Itoa(remoteAs),
+					}),
+				),
+			},
+		},
+	})
+}
+
+// testAccWaitForBgpSession waits until a BGP session of the named connection is reported by the router
+func testAccWaitForBgpSession(t *testing.T, name string) {
+	c := client.NewClient(client.GetConfigFromEnv())
+	for deadline := time.Now().Add(30 * time.Second); time.Now().Before(deadline); time.Sleep(time.Second) {
+		sessions, err := c.ListBgpSessions()
+		if err != nil {
+			t.Fatalf("cannot list BGP sessions: %v", err)
+		}
+		for _, s := range sessions {
+			if strings.HasPrefix(s.Name, name) {
+				return
+			}
+		}
+	}
+	t.Fatalf("BGP session of connection %q is not started", name)
+}
+
+func testAccBgpSessionsDataSourceConfig(dependsOn string) string {
+	return fmt.Sprintf(`
+		data "mikrotik_bgp_sessions" "all" {
+			depends_on = [%s]
+		}
+	`, dependsOn)
+}
@@ -0,0 +1,135 @@
+package mikrotik
+
+import (
+	"context"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type export struct {
+	client *client.Mikrotik
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                     = &export{}
+	_ datasource.DataSourceWithConfigure        = &export{}
+	_ datasource.DataSourceWithConfigValidators = &export{}
+)
+
+// NewExportDataSource is a helper function to simplify the provider implementation.
+func NewExportDataSource() datasource.DataSource {
+	return &export{}
+}
+
+func (d *export) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*client.Mikrotik)
+}
+
+// Metadata returns the data source type name.
+func (d *export) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_export"
+}
+
+// Schema defines the schema for the data source.
+func (d *export) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Exports configuration of the MikroTik device using `/export` command.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Identifier of this data source.",
+			},
+			"path": schema.StringAttribute{
+				Optional:    true,
+				Description: "Menu path to limit the export to, e.g. `/ip/firewall`. Whole configuration is exported if not set.",
+			},
+			"compact": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Export only modified configuration.",
+			},
+			"verbose": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Export whole configuration including default values.",
+			},
+			"hide_sensitive": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Do not export sensitive values such as passwords and keys. This is the default on RouterOS v7.",
+			},
+			"show_sensitive": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Export sensitive values such as passwords and keys. Supported on RouterOS v7 only.",
+			},
+			"content": schema.StringAttribute{
+				Computed:    true,
+				Description: "Exported configuration script, without the leading timestamp comment.",
+			},
+			"sha256": schema.StringAttribute{
+				Computed:    true,
+				Description: "SHA256 hash of the exported configuration script.",
+			},
+		},
+	}
+}
+
+func (d *export) ConfigValidators(context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.Conflicting(
+			path.MatchRoot("compact"),
+			path.MatchRoot("verbose"),
+		),
+		datasourcevalidator.Conflicting(
+			path.MatchRoot("hide_sensitive"),
+			path.MatchRoot("show_sensitive"),
+		),
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *export) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state exportModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := d.client.Export(client.ExportOptions{
+		Path:          state.Path.ValueString(),
+		Compact:       state.Compact.ValueBool(),
+		Verbose:       state.Verbose.ValueBool(),
+		HideSensitive: state.HideSensitive.ValueBool(),
+		ShowSensitive: state.ShowSensitive.ValueBool(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error exporting configuration", err.Error())
+		return
+	}
+
+	state.Id = tftypes.StringValue(result.Sha256)
+	state.Content = tftypes.StringValue(result.Content)
+	state.Sha256 = tftypes.StringValue(result.Sha256)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+type exportModel struct {
+	Id            tftypes.String `tfsdk:"id"`
+	Path          tftypes.String `tfsdk:"path"`
+	Compact       tftypes.Bool   `tfsdk:"compact"`
+	Verbose       tftypes.Bool   `tfsdk:"verbose"`
+	HideSensitive tftypes.Bool   `tfsdk:"hide_sensitive"`
+	ShowSensitive tftypes.Bool   `tfsdk:"show_sensitive"`
+	Content       tftypes.String `tfsdk:"content"`
+	Sha256        tftypes.String `tfsdk:"sha256"`
+}
@@ -0,0 +1,40 @@
+package mikrotik
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccExportDataSource_basic(t *testing.T) {
+	dataSourceName := "data.mikrotik_export.dns"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					data "mikrotik_export" "dns" {
+						path    = "/ip/dns"
+						verbose = true
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
+					resource.TestMatchResourceAttr(dataSourceName, "content", regexp.MustCompile(`/ip dns`)),
+					resource.TestMatchResourceAttr(dataSourceName, "sha256", regexp.MustCompile(`^[0-9a-f]{64}$`)),
+				),
+			},
+			{
+				Config: `
+					data "mikrotik_export" "dns" {
+						compact = true
+						verbose = true
+					}
+				`,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+		},
+	})
+}
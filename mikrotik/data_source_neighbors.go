@@ -0,0 +1,111 @@
+package mikrotik
+
+import (
+	"context"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/ddelnano/terraform-provider-mikrotik/mikrotik/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type neighbors struct {
+	client *client.Mikrotik
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &neighbors{}
+	_ datasource.DataSourceWithConfigure = &neighbors{}
+)
+
+// NewNeighborsDataSource is a helper function to simplify the provider implementation.
+func NewNeighborsDataSource() datasource.DataSource {
+	return &neighbors{}
+}
+
+func (d *neighbors) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*client.Mikrotik)
+}
+
+// Metadata returns the data source type name.
+func (d *neighbors) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_neighbors"
+}
+
+// Schema defines the schema for the data source.
+func (d *neighbors) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists neighbors discovered by the MikroTik device via MNDP, CDP or LLDP.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Identifier of this data source.",
+			},
+			"neighbors": schema.ListAttribute{
+				Computed: true,
+				ElementType: tftypes.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"id":          tftypes.StringType,
+						"address":     tftypes.StringType,
+						"address6":    tftypes.StringType,
+						"board":       tftypes.StringType,
+						"identity":    tftypes.StringType,
+						"interface":   tftypes.StringType,
+						"mac_address": tftypes.StringType,
+						"platform":    tftypes.StringType,
+						"version":     tftypes.StringType,
+					},
+				},
+				Description: "List of discovered neighbors. Each item contains `identity`, `platform`, `version`, `board`, " +
+					"`mac_address`, `address`, `address6` and the local `interface` the neighbor was discovered on.",
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *neighbors) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state neighborsModel
+
+	records, err := d.client.ListIpNeighbors()
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading neighbors", err.Error())
+		return
+	}
+
+	state.Id = tftypes.StringValue("neighbors")
+	state.Neighbors = make([]neighborModel, len(records))
+	for i := range records {
+		if err := utils.MikrotikStructToTerraformModel(ctx, &records[i], &state.Neighbors[i]); err != nil {
+			resp.Diagnostics.AddError("Cannot copy model: MikroTik -> Terraform", err.Error())
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+type neighborsModel struct {
+	Id        tftypes.String  `tfsdk:"id"`
+	Neighbors []neighborModel `tfsdk:"neighbors"`
+}
+
+type neighborModel struct {
+	Id         tftypes.String `tfsdk:"id"`
+	Address    tftypes.String `tfsdk:"address"`
+	Address6   tftypes.String `tfsdk:"address6"`
+	Board      tftypes.String `tfsdk:"board"`
+	Identity   tftypes.String `tfsdk:"identity"`
+	Interface  tftypes.String `tfsdk:"interface"`
+	MacAddress tftypes.String `tfsdk:"mac_address"`
+	Platform   tftypes.String `tfsdk:"platform"`
+	Version    tftypes.String `tfsdk:"version"`
+}
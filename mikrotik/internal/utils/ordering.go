@@ -0,0 +1,108 @@
+package utils
+
+// Move describes a single move of an item in the ordered list: the item is placed right before Destination.
+// Empty Destination means the end of the list.
+type Move struct {
+	ID          string
+	Destination string
+}
+
+// OrderingMoves calculates moves required to place desired items in the given order within the table.
+//
+// The table holds IDs of all items in their current order and must contain every desired item.
+// Items not listed in desired are never moved, but may end up between desired items.
+// The longest subsequence of desired items which already follows the desired order stays in place,
+// so only the remaining items are moved.
+func OrderingMoves(table []string, desired []string) []Move {
+	keep := longestOrderedSubsequence(table, desired)
+	table = append([]string(nil), table...)
+
+	var moves []Move
+	for i, id := range desired {
+		if keep[i] {
+			continue
+		}
+
+		destination := ""
+		if i == 0 {
+			// place the first item before the first one staying in place
+			for j := 1; j < len(desired); j++ {
+				if keep[j] {
+					destination = desired[j]
+					break
+				}
+			}
+		} else {
+			// place the item right after the previous one, which is already in place
+			if pos := indexOf(table, desired[i-1]); pos+1 < len(table) {
+				destination = table[pos+1]
+			}
+		}
+		if destination == id {
+			continue
+		}
+
+		moves = append(moves, Move{ID: id, Destination: destination})
+		table = moveBefore(table, id, destination)
+	}
+
+	return moves
+}
+
+// longestOrderedSubsequence marks the longest subsequence of desired items which are already ordered in the table.
+func longestOrderedSubsequence(table []string, desired []string) []bool {
+	position := make(map[string]int, len(table))
+	for i, id := range table {
+		position[id] = i
+	}
+
+	length := make([]int, len(desired))
+	prev := make([]int, len(desired))
+	last := -1
+	for i := range desired {
+		length[i], prev[i] = 1, -1
+		for j := 0; j < i; j++ {
+			if position[desired[j]] < position[desired[i]] && length[j]+1 > length[i] {
+				length[i], prev[i] = length[j]+1, j
+			}
+		}
+		if last < 0 || length[i] > length[last] {
+			last = i
+		}
+	}
+
+	keep := make([]bool, len(desired))
+	for i := last; i >= 0; i = prev[i] {
+		keep[i] = true
+	}
+
+	return keep
+}
+
+func moveBefore(table []string, id, destination string) []string {
+	result := make([]string, 0, len(table))
+	for _, v := range table {
+		if v == id {
+			continue
+		}
+		if v == destination {
+			result = append(result, id)
+		}
+		result = append(result, v)
+	}
+	if destination == "" {
+		result = append(result, id)
+	}
+
+	return result
+}
+
+func indexOf(items []string, item string) int {
+	for i, v := range items {
+		if v == item {
+			return i
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,77 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrderingMoves(t *testing.T) {
+	testCases := []struct {
+		name          string
+		table         []string
+		desired       []string
+		expectedTable []string
+		expectedMoves int
+	}{
+		{
+			name:          "already ordered",
+			table:         []string{"*1", "*2", "*3"},
+			desired:       []string{"*1", "*2", "*3"},
+			expectedTable: []string{"*1", "*2", "*3"},
+		},
+		{
+			name:          "empty list",
+			table:         []string{"*1", "*2"},
+			desired:       []string{},
+			expectedTable: []string{"*1", "*2"},
+		},
+		{
+			name:          "last item moved to the beginning",
+			table:         []string{"*1", "*2", "*3", "*4"},
+			desired:       []string{"*4", "*1", "*2", "*3"},
+			expectedTable: []string{"*4", "*1", "*2", "*3"},
+			expectedMoves: 1,
+		},
+		{
+			name:          "first item moved to the end",
+			table:         []string{"*1", "*2", "*3", "*4"},
+			desired:       []string{"*2", "*3", "*4", "*1"},
+			expectedTable: []string{"*2", "*3", "*4", "*1"},
+			expectedMoves: 1,
+		},
+		{
+			name:          "reversed order",
+			table:         []string{"*1", "*2", "*3"},
+			desired:       []string{"*3", "*2", "*1"},
+			expectedTable: []string{"*3", "*2", "*1"},
+			expectedMoves: 2,
+		},
+		{
+			name:          "foreign items are kept in place",
+			table:         []string{"*1", "*a", "*2", "*b", "*3", "*c"},
+			desired:       []string{"*3", "*1", "*2"},
+			expectedTable: []string{"*3", "*1", "*a", "*2", "*b", "*c"},
+			expectedMoves: 1,
+		},
+		{
+			name:          "item moved to the end of the table",
+			table:         []string{"*1", "*2", "*a", "*3"},
+			desired:       []string{"*1", "*3", "*2"},
+			expectedTable: []string{"*1", "*a", "*3", "*2"},
+			expectedMoves: 1,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			moves := OrderingMoves(tc.table, tc.desired)
+			assert.Len(t, moves, tc.expectedMoves)
+
+			table := tc.table
+			for _, m := range moves {
+				table = moveBefore(table, m.ID, m.Destination)
+			}
+			assert.Equal(t, tc.expectedTable, table)
+		})
+	}
+}
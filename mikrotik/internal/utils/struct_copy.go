@@ -24,6 +24,16 @@ func TerraformModelToMikrotikStruct(ctx context.Context, src interface{}, dest c
 	return copyStruct(ctx, src, dest)
 }
 
+// MikrotikSingletonToTerraformModel is a wrapper for copyStruct() to ensure proper src/dest typing
+func MikrotikSingletonToTerraformModel(ctx context.Context, src client.Singleton, dest interface{}) error {
+	return copyStruct(ctx, src, dest)
+}
+
+// TerraformModelToMikrotikSingleton is a wrapper for copyStruct() to ensure proper src/dest typing
+func TerraformModelToMikrotikSingleton(ctx context.Context, src interface{}, dest client.Singleton) error {
+	return copyStruct(ctx, src, dest)
+}
+
 // copyStruct copies fields of src struct to fields of dest struct.
 //
 // The fields matching is done based on field names (case insensitive).
@@ -90,6 +100,14 @@ func copyStruct(ctx context.Context, src, dest interface{}) error {
 			if err := coreTypeToCoreType(srcField, destField); err != nil {
 				return err
 			}
+		case reflect.Pointer:
+			// optional core type -> terraform type, unset value is null
+			if _, ok := destField.Interface().(attr.Value); !ok {
+				return fmt.Errorf("unsupported destination of optional field %q", srcFieldType.Name)
+			}
+			if err := optionalCoreTypeToTerraformType(srcField, destField); err != nil {
+				return err
+			}
 		case reflect.Struct:
 			// source is terraform type and dest is core type
 			if _, ok := srcField.Interface().(attr.Value); ok {
@@ -165,7 +183,38 @@ func coreTypeToTerraformType(src, dest reflect.Value) error {
 	return nil
 }
 
+// optionalCoreTypeToTerraformType converts pointer to a core type, nil pointer results in null value.
+func optionalCoreTypeToTerraformType(src, dest reflect.Value) error {
+	if !src.IsNil() {
+		return coreTypeToTerraformType(src.Elem(), dest)
+	}
+	switch src.Type().Elem().Kind() {
+	case reflect.Bool:
+		dest.Set(reflect.ValueOf(tftypes.BoolNull()))
+	default:
+		return fmt.Errorf("unsupported optional field type %q", src.Type().Elem().Kind())
+	}
+
+	return nil
+}
+
 func terraformTypeToCoreType(src, dest reflect.Value) error {
+	if dest.Kind() == reflect.Pointer {
+		// null and unknown values are not set in optional fields
+		f, ok := src.Interface().(tftypes.Bool)
+		if !ok || dest.Type().Elem().Kind() != reflect.Bool {
+			return fmt.Errorf("unsupported optional field type assignment: %s -> %s", src.Type().Name(), dest.Type())
+		}
+		if f.IsNull() || f.IsUnknown() {
+			dest.Set(reflect.Zero(dest.Type()))
+			return nil
+		}
+		value := f.ValueBool()
+		dest.Set(reflect.ValueOf(&value))
+
+		return nil
+	}
+
 	switch f := src.Interface().(type) {
 	case tftypes.Int64:
 		dest.SetInt(f.ValueInt64())
@@ -206,7 +255,9 @@ func terraformTypeToCoreType(src, dest reflect.Value) error {
 			return fmt.Errorf("unsupported list element types: %s -> []%s", src.Type().Name(), dest.Type().Elem().Kind())
 		}
 		targetPtr := reflect.New(reflect.SliceOf(sliceType))
-		diag = f.ElementsAs(context.TODO(), targetPtr.Interface(), false)
+		if len(f.Elements()) > 0 {
+			diag = f.ElementsAs(context.TODO(), targetPtr.Interface(), false)
+		}
 
 		if diag.HasError() {
 			return fmt.Errorf("%s", diag.Errors())
@@ -503,6 +503,80 @@ func TestCopyStruct(t *testing.T) {
 				StringList: []string{"new value 1", "new value 2"},
 			},
 		},
+		{
+			name: "unknown and null terraform lists to core type",
+			src: struct {
+				IntList    tftypes.List
+				StringList tftypes.List
+			}{
+				IntList:    tftypes.ListUnknown(tftypes.Int64Type),
+				StringList: tftypes.ListNull(tftypes.StringType),
+			},
+			dest: &struct {
+				IntList    []int
+				StringList []string
+			}{
+				IntList:    []int{10, 20, 30},
+				StringList: []string{"old value"},
+			},
+			expected: &struct {
+				IntList    []int
+				StringList []string
+			}{
+				IntList:    []int(nil),
+				StringList: []string(nil),
+			},
+		},
+		{
+			name: "terraform bools to optional core type",
+			src: struct {
+				Connect  tftypes.Bool
+				Listen   tftypes.Bool
+				Multihop tftypes.Bool
+			}{
+				Connect:  tftypes.BoolValue(false),
+				Listen:   tftypes.BoolNull(),
+				Multihop: tftypes.BoolUnknown(),
+			},
+			dest: &struct {
+				Connect  *bool
+				Listen   *bool
+				Multihop *bool
+			}{
+				Listen:   boolPointer(true),
+				Multihop: boolPointer(true),
+			},
+			expected: &struct {
+				Connect  *bool
+				Listen   *bool
+				Multihop *bool
+			}{
+				Connect: boolPointer(false),
+			},
+		},
+		{
+			name: "optional core type to terraform bools",
+			src: struct {
+				Connect *bool
+				Listen  *bool
+			}{
+				Connect: boolPointer(false),
+			},
+			dest: &struct {
+				Connect tftypes.Bool
+				Listen  tftypes.Bool
+			}{
+				Connect: tftypes.BoolValue(true),
+				Listen:  tftypes.BoolValue(true),
+			},
+			expected: &struct {
+				Connect tftypes.Bool
+				Listen  tftypes.Bool
+			}{
+				Connect: tftypes.BoolValue(false),
+				Listen:  tftypes.BoolNull(),
+			},
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -651,3 +725,7 @@ func TestCopyMikrotikToTerraform(t *testing.T) {
 		})
 	}
 }
+
+func boolPointer(b bool) *bool {
+	return &b
+}
@@ -178,31 +178,77 @@ func (p *ProviderFramework) Configure(ctx context.Context, req provider.Configur
 }
 
 func (p *ProviderFramework) DataSources(ctx context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		NewArpTableDataSource,
+		NewBgpSessionsDataSource,
+		NewExportDataSource,
+		NewNeighborsDataSource,
+	}
 }
 
 func (p *ProviderFramework) Resources(ctx context.Context) []func() resource.Resource {
 	return defaultaware.WrapResources([]func() resource.Resource{
+		NewBandwidthServerResource,
+		NewBgpConnectionResource,
 		NewBgpInstanceResource,
 		NewBgpPeerResource,
+		NewBgpTemplateResource,
 		NewBridgePortResource,
 		NewBridgeResource,
 		NewBridgeVlanResource,
+		NewCertificateResource,
 		NewDhcpLeaseResource,
 		NewDhcpServerNetworkResource,
 		NewDhcpServerResource,
 		NewDnsRecordResource,
+		NewDnsResource,
+		NewFileResource,
+		NewFirewallAddressListBulkResource,
+		NewFirewallAddressListResource,
 		NewFirewallFilterRuleResource,
+		NewFirewallFilterRulesetResource,
+		NewFirewallMangleRuleResource,
+		NewFirewallNatRuleResource,
+		NewFirewallRawRuleResource,
 		NewInterfaceListMemberResource,
 		NewInterfaceListResource,
 		NewInterfaceWireguardPeerResource,
 		NewInterfaceWireguardResource,
 		NewIpAddressResource,
+		NewIpRouteResource,
+		NewIpServiceResource,
 		NewIpv6AddressResource,
+		NewIpv6FirewallAddressListResource,
+		NewIpv6FirewallFilterRuleResource,
+		NewIpv6FirewallMangleRuleResource,
+		NewIpv6FirewallNatRuleResource,
+		NewIpv6RouteResource,
+		NewMacServerResource,
+		NewMacWinboxServerResource,
+		NewNeighborDiscoverySettingsResource,
+		NewNtpClientResource,
+		NewOspfAreaResource,
+		NewOspfInstanceResource,
+		NewOspfInterfaceTemplateResource,
 		NewPoolResource,
+		NewPppAaaResource,
+		NewRadiusResource,
+		NewRomonResource,
+		NewRoutingFilterChainResource,
+		NewRoutingFilterRuleResource,
+		NewRoutingRuleResource,
+		NewRoutingTableResource,
 		NewSchedulerResource,
 		NewScriptResource,
+		NewSshServerResource,
+		NewSystemClockResource,
+		NewSystemIdentityResource,
+		NewUserAaaResource,
+		NewUserGroupResource,
+		NewUserResource,
+		NewUserSshKeyResource,
 		NewVlanInterfaceResource,
+		NewVrfResource,
 		NewWirelessInterfaceResource,
 	},
 	)
@@ -0,0 +1,126 @@
+package mikrotik
+
+import (
+	"context"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type bandwidthServer struct {
+	client *client.Mikrotik
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &bandwidthServer{}
+	_ resource.ResourceWithConfigure   = &bandwidthServer{}
+	_ resource.ResourceWithImportState = &bandwidthServer{}
+)
+
+// NewBandwidthServerResource is a helper function to simplify the provider implementation.
+func NewBandwidthServerResource() resource.Resource {
+	return &bandwidthServer{}
+}
+
+func (r *bandwidthServer) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*client.Mikrotik)
+}
+
+// Metadata returns the resource type name.
+func (r *bandwidthServer) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bandwidth_server"
+}
+
+// Schema defines the schema for the resource.
+func (s *bandwidthServer) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages bandwidth test server settings of the MikroTik device. Destroying the resource restores default settings.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Unique ID of this resource.",
+			},
+			"allocate_udp_ports_from": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(2000),
+				Description: "First UDP port used by bandwidth tests.",
+			},
+			"authenticate": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether clients must authenticate with a router user.",
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether the bandwidth test server is enabled.",
+			},
+			"max_sessions": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(100),
+				Description: "Maximum number of concurrent bandwidth tests.",
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *bandwidthServer) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var terraformModel bandwidthServerModel
+	var mikrotikModel client.BandwidthServer
+	GenericCreateSingleton(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *bandwidthServer) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var terraformModel bandwidthServerModel
+	var mikrotikModel client.BandwidthServer
+	GenericReadSingleton(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *bandwidthServer) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var terraformModel bandwidthServerModel
+	var mikrotikModel client.BandwidthServer
+	GenericUpdateSingleton(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *bandwidthServer) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var terraformModel bandwidthServerModel
+	var mikrotikModel client.BandwidthServer
+	GenericDeleteSingleton(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+func (r *bandwidthServer) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+type bandwidthServerModel struct {
+	Id                   tftypes.String `tfsdk:"id"`
+	AllocateUdpPortsFrom tftypes.Int64  `tfsdk:"allocate_udp_ports_from"`
+	Authenticate         tftypes.Bool   `tfsdk:"authenticate"`
+	Enabled              tftypes.Bool   `tfsdk:"enabled"`
+	MaxSessions          tftypes.Int64  `tfsdk:"max_sessions"`
+}
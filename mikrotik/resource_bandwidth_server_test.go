@@ -0,0 +1,60 @@
+package mikrotik
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccMikrotikBandwidthServer_basic(t *testing.T) {
+	resourceName := "mikrotik_bandwidth_server.settings"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMikrotikBandwidthServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "mikrotik_bandwidth_server" "settings" {
+						enabled      = false
+						max_sessions = 10
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "/tool/bandwidth-server"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "authenticate", "true"),
+					resource.TestCheckResourceAttr(resourceName, "max_sessions", "10"),
+				),
+			},
+			{
+				ImportState:       true,
+				ResourceName:      resourceName,
+				ImportStateId:     "/tool/bandwidth-server",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckMikrotikBandwidthServerDestroy(s *terraform.State) error {
+	c := client.NewClient(client.GetConfigFromEnv())
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "mikrotik_bandwidth_server" {
+			continue
+		}
+
+		settings, err := c.FindBandwidthServer()
+		if err != nil {
+			return err
+		}
+
+		if !settings.Enabled || settings.MaxSessions != 100 {
+			return fmt.Errorf("bandwidth server settings were not restored to defaults: %+v", settings)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,159 @@
+package mikrotik
+
+import (
+	"context"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type bgpConnection struct {
+	client *client.Mikrotik
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &bgpConnection{}
+	_ resource.ResourceWithConfigure   = &bgpConnection{}
+	_ resource.ResourceWithImportState = &bgpConnection{}
+)
+
+// NewBgpConnectionResource is a helper function to simplify the provider implementation.
+func NewBgpConnectionResource() resource.Resource {
+	return &bgpConnection{}
+}
+
+func (r *bgpConnection) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*client.Mikrotik)
+}
+
+// Metadata returns the resource type name.
+func (r *bgpConnection) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bgp_connection"
+}
+
+// Schema defines the schema for the resource.
+func (s *bgpConnection) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates a MikroTik BGP connection only supported by RouterOS v7+. On earlier versions use `mikrotik_bgp_instance` and `mikrotik_bgp_peer` instead.",
+		Attributes: mergeAttributes(
+			bgpTemplateAttributes(),
+			map[string]schema.Attribute{
+				"connect": schema.BoolAttribute{
+					Optional:    true,
+					Computed:    true,
+					Description: "Whether to initiate the connection to the remote peer. If unset, the value is inherited from templates or RouterOS defaults.",
+				},
+				"listen": schema.BoolAttribute{
+					Optional:    true,
+					Computed:    true,
+					Description: "Whether to listen for the connection from the remote peer. If unset, the value is inherited from templates or RouterOS defaults.",
+				},
+				"local_address": schema.StringAttribute{
+					Optional:    true,
+					Computed:    true,
+					Description: "Local address used to establish the connection.",
+				},
+				"local_role": schema.StringAttribute{
+					Required:    true,
+					Description: "BGP role of the local router: `ebgp`, `ibgp`, `ibgp-rr`, `ibgp-rr-client`, `ebgp-customer`, `ebgp-peer`, `ebgp-provider`, `ebgp-rs` or `ebgp-rs-client`.",
+				},
+				"remote_address": schema.StringAttribute{
+					Required:    true,
+					Description: "Address or prefix of the remote peer. A prefix allows dynamic peers to connect from any address within it.",
+				},
+				"remote_as": schema.Int64Attribute{
+					Optional:    true,
+					Computed:    true,
+					Description: "32-bit AS number of the remote peer. If not set, any AS number is accepted.",
+				},
+				"remote_port": schema.Int64Attribute{
+					Optional:    true,
+					Computed:    true,
+					Description: "TCP port of the remote peer.",
+				},
+				"tcp_md5_key": schema.StringAttribute{
+					Optional:    true,
+					Computed:    true,
+					Sensitive:   true,
+					Description: "Key used to authenticate the connection with TCP MD5 signature as described in RFC 2385.",
+				},
+				"vrf": schema.StringAttribute{
+					Optional:    true,
+					Computed:    true,
+					Description: "Name of the VRF the connection operates in. Received routes are still installed into `routing_table`, so set it to the VRF name to keep them in the VRF table.",
+				},
+			},
+		),
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *bgpConnection) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var terraformModel bgpConnectionModel
+	var mikrotikModel client.BgpConnection
+	GenericCreateResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *bgpConnection) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var terraformModel bgpConnectionModel
+	var mikrotikModel client.BgpConnection
+	GenericReadResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *bgpConnection) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var terraformModel bgpConnectionModel
+	var mikrotikModel client.BgpConnection
+	GenericUpdateResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *bgpConnection) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var terraformModel bgpConnectionModel
+	var mikrotikModel client.BgpConnection
+	GenericDeleteResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+func (r *bgpConnection) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
+
+type bgpConnectionModel struct {
+	Id                 tftypes.String `tfsdk:"id"`
+	Name               tftypes.String `tfsdk:"name"`
+	AddressFamilies    tftypes.Set    `tfsdk:"address_families"`
+	As                 tftypes.Int64  `tfsdk:"as"`
+	Comment            tftypes.String `tfsdk:"comment"`
+	Connect            tftypes.Bool   `tfsdk:"connect"`
+	Disabled           tftypes.Bool   `tfsdk:"disabled"`
+	HoldTime           tftypes.String `tfsdk:"hold_time"`
+	InputFilter        tftypes.String `tfsdk:"input_filter"`
+	KeepaliveTime      tftypes.String `tfsdk:"keepalive_time"`
+	Listen             tftypes.Bool   `tfsdk:"listen"`
+	LocalAddress       tftypes.String `tfsdk:"local_address"`
+	LocalRole          tftypes.String `tfsdk:"local_role"`
+	Multihop           tftypes.Bool   `tfsdk:"multihop"`
+	NexthopChoice      tftypes.String `tfsdk:"nexthop_choice"`
+	OutputFilterChain  tftypes.String `tfsdk:"output_filter_chain"`
+	OutputRedistribute tftypes.Set    `tfsdk:"output_redistribute"`
+	RemoteAddress      tftypes.String `tfsdk:"remote_address"`
+	RemoteAs           tftypes.Int64  `tfsdk:"remote_as"`
+	RemotePort         tftypes.Int64  `tfsdk:"remote_port"`
+	RouterId           tftypes.String `tfsdk:"router_id"`
+	RoutingTable       tftypes.String `tfsdk:"routing_table"`
+	TcpMd5Key          tftypes.String `tfsdk:"tcp_md5_key"`
+	Templates          tftypes.List   `tfsdk:"templates"`
+	UseBfd             tftypes.Bool   `tfsdk:"use_bfd"`
+	Vrf                tftypes.String `tfsdk:"vrf"`
+}
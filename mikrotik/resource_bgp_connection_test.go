@@ -0,0 +1,154 @@
+package mikrotik
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var terraformResourceTypeBgpConnection string = "mikrotik_bgp_connection"
+
+func TestBgpConnection_withTemplate(t *testing.T) {
+	client.SkipIfRouterOSV6OrEarlier(t, sysResources)
+	resourceName := terraformResourceTypeBgpConnection + ".testacc"
+	templateResourceName := terraformResourceTypeBgpTemplate + ".testacc"
+	name := "testacc-" + client.RandomString()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckBgpConnectionDestroy,
+			testAccCheckBgpTemplateDestroy,
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBgpConnectionConfig(name, 65533),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "local_role", "ebgp"),
+					resource.TestCheckResourceAttr(resourceName, "remote_as", "65533"),
+					resource.TestCheckResourceAttr(resourceName, "templates.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "templates.0", templateResourceName, "name"),
+					resource.TestCheckResourceAttr(templateResourceName, "as", "65530"),
+					resource.TestCheckResourceAttr(templateResourceName, "input_filter", "testacc-in"),
+				),
+			},
+			{
+				Config: testAccBgpConnectionConfig(name, 65534),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "remote_as", "65534"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateId:           name,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"tcp_md5_key"},
+			},
+			{
+				ResourceName:      templateResourceName,
+				ImportState:       true,
+				ImportStateId:     name + "-template",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestBgpConnection_vrf(t *testing.T) {
+	client.SkipIfRouterOSV6OrEarlier(t, sysResources)
+	resourceName := terraformResourceTypeBgpConnection + ".testacc"
+	name := "testacc-" + client.RandomString()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckBgpConnectionDestroy,
+			testAccCheckVrfDestroy,
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "mikrotik_bridge" "testacc" {
+						name = %[1]q
+					}
+
+					resource "mikrotik_vrf" "testacc" {
+						name       = %[1]q
+						interfaces = [mikrotik_bridge.testacc.name]
+					}
+
+					resource "mikrotik_bgp_connection" "testacc" {
+						name           = %[1]q
+						as             = 65530
+						disabled       = true
+						local_role     = "ebgp"
+						remote_address = "172.21.16.1"
+						remote_as      = 65533
+						vrf            = mikrotik_vrf.testacc.name
+						routing_table  = mikrotik_vrf.testacc.name
+					}
+				`, name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "vrf", name),
+					resource.TestCheckResourceAttr(resourceName, "routing_table", name),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateId:           name,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"tcp_md5_key"},
+			},
+		},
+	})
+}
+
+func testAccCheckBgpConnectionDestroy(s *terraform.State) error {
+	c := client.NewClient(client.GetConfigFromEnv())
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != terraformResourceTypeBgpConnection {
+			continue
+		}
+
+		remoteRecord, err := c.FindBgpConnection(rs.Primary.Attributes["name"])
+		if err != nil && !client.IsNotFoundError(err) {
+			return fmt.Errorf("expected not found error, got %+#v", err)
+		}
+
+		if remoteRecord != nil {
+			return fmt.Errorf("resource %T with id %q still exists in remote system", remoteRecord, remoteRecord.Id)
+		}
+	}
+	return nil
+}
+
+func testAccBgpConnectionConfig(name string, remoteAs int) string {
+	return fmt.Sprintf(`
+		resource "mikrotik_bgp_template" "testacc" {
+			name                = "%[1]s-template"
+			as                  = 65530
+			address_families    = ["ip"]
+			input_filter        = "testacc-in"
+			output_filter_chain = "testacc-out"
+		}
+
+		resource "mikrotik_bgp_connection" "testacc" {
+			name           = %[1]q
+			as             = 65530
+			disabled       = true
+			local_role     = "ebgp"
+			remote_address = "172.21.16.1"
+			remote_as      = %[2]d
+			templates      = [mikrotik_bgp_template.testacc.name]
+		}
+	`, name, remoteAs)
+}
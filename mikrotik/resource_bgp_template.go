@@ -0,0 +1,199 @@
+package mikrotik
+
+import (
+	"context"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type bgpTemplate struct {
+	client *client.Mikrotik
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &bgpTemplate{}
+	_ resource.ResourceWithConfigure   = &bgpTemplate{}
+	_ resource.ResourceWithImportState = &bgpTemplate{}
+)
+
+// NewBgpTemplateResource is a helper function to simplify the provider implementation.
+func NewBgpTemplateResource() resource.Resource {
+	return &bgpTemplate{}
+}
+
+func (r *bgpTemplate) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*client.Mikrotik)
+}
+
+// Metadata returns the resource type name.
+func (r *bgpTemplate) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bgp_template"
+}
+
+// bgpTemplateAttributes returns attributes shared by BGP templates and connections.
+func bgpTemplateAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+			Description: "Unique MikroTik identifier.",
+		},
+		"name": schema.StringAttribute{
+			Required:    true,
+			Description: "Name of the item.",
+		},
+		"address_families": schema.SetAttribute{
+			Optional:    true,
+			Computed:    true,
+			ElementType: tftypes.StringType,
+			Description: "Address families about which routing information is exchanged, e.g. `ip`, `ipv6`, `l2vpn` or `vpnv4`.",
+		},
+		"as": schema.Int64Attribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "32-bit local AS number.",
+		},
+		"comment": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "Comment to the item.",
+		},
+		"disabled": schema.BoolAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "Whether the item is disabled.",
+		},
+		"hold_time": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "BGP Hold Time value to use when negotiating with peers, e.g. `3m` or `infinity`.",
+		},
+		"input_filter": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "Name of the routing filter chain applied to the incoming routing information.",
+		},
+		"keepalive_time": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "Interval between keepalive messages.",
+		},
+		"multihop": schema.BoolAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "Whether the remote peer is more than one hop away. If unset, the value is inherited from templates or RouterOS defaults.",
+		},
+		"nexthop_choice": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "Affects the outgoing NEXT_HOP attribute selection: `default`, `force-self` or `propagate`.",
+		},
+		"output_filter_chain": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "Name of the routing filter chain applied to the outgoing routing information.",
+		},
+		"output_redistribute": schema.SetAttribute{
+			Optional:    true,
+			Computed:    true,
+			ElementType: tftypes.StringType,
+			Description: "Route types to redistribute to the peers, e.g. `connected`, `static`, `ospf` or `bgp`.",
+		},
+		"router_id": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "BGP router ID or name of the router ID instance to use.",
+		},
+		"routing_table": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "Routing table the received routes are installed into.",
+		},
+		"templates": schema.ListAttribute{
+			Optional:    true,
+			Computed:    true,
+			ElementType: tftypes.StringType,
+			Description: "Names of the templates to inherit settings from.",
+		},
+		"use_bfd": schema.BoolAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "Whether to use BFD protocol for fast state detection. If unset, the value is inherited from templates or RouterOS defaults.",
+		},
+	}
+}
+
+// Schema defines the schema for the resource.
+func (s *bgpTemplate) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates a MikroTik BGP template only supported by RouterOS v7+. Templates hold settings shared by several BGP connections.",
+		Attributes:  bgpTemplateAttributes(),
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *bgpTemplate) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var terraformModel bgpTemplateModel
+	var mikrotikModel client.BgpTemplate
+	GenericCreateResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *bgpTemplate) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var terraformModel bgpTemplateModel
+	var mikrotikModel client.BgpTemplate
+	GenericReadResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *bgpTemplate) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var terraformModel bgpTemplateModel
+	var mikrotikModel client.BgpTemplate
+	GenericUpdateResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *bgpTemplate) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var terraformModel bgpTemplateModel
+	var mikrotikModel client.BgpTemplate
+	GenericDeleteResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+func (r *bgpTemplate) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
+
+type bgpTemplateModel struct {
+	Id                 tftypes.String `tfsdk:"id"`
+	Name               tftypes.String `tfsdk:"name"`
+	AddressFamilies    tftypes.Set    `tfsdk:"address_families"`
+	As                 tftypes.Int64  `tfsdk:"as"`
+	Comment            tftypes.String `tfsdk:"comment"`
+	Disabled           tftypes.Bool   `tfsdk:"disabled"`
+	HoldTime           tftypes.String `tfsdk:"hold_time"`
+	InputFilter        tftypes.String `tfsdk:"input_filter"`
+	KeepaliveTime      tftypes.String `tfsdk:"keepalive_time"`
+	Multihop           tftypes.Bool   `tfsdk:"multihop"`
+	NexthopChoice      tftypes.String `tfsdk:"nexthop_choice"`
+	OutputFilterChain  tftypes.String `tfsdk:"output_filter_chain"`
+	OutputRedistribute tftypes.Set    `tfsdk:"output_redistribute"`
+	RouterId           tftypes.String `tfsdk:"router_id"`
+	RoutingTable       tftypes.String `tfsdk:"routing_table"`
+	Templates          tftypes.List   `tfsdk:"templates"`
+	UseBfd             tftypes.Bool   `tfsdk:"use_bfd"`
+}
@@ -0,0 +1,81 @@
+package mikrotik
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var terraformResourceTypeBgpTemplate string = "mikrotik_bgp_template"
+
+func TestBgpTemplate_basic(t *testing.T) {
+	client.SkipIfRouterOSV6OrEarlier(t, sysResources)
+	resourceName := terraformResourceTypeBgpTemplate + ".testacc"
+	name := "testacc-" + client.RandomString()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBgpTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBgpTemplateConfig(name, "3m", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "as", "65530"),
+					resource.TestCheckResourceAttr(resourceName, "hold_time", "3m"),
+					resource.TestCheckResourceAttr(resourceName, "multihop", "true"),
+					resource.TestCheckResourceAttr(resourceName, "address_families.#", "1"),
+				),
+			},
+			{
+				Config: testAccBgpTemplateConfig(name, "1m", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "hold_time", "1m"),
+					resource.TestCheckResourceAttr(resourceName, "multihop", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     name,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckBgpTemplateDestroy(s *terraform.State) error {
+	c := client.NewClient(client.GetConfigFromEnv())
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != terraformResourceTypeBgpTemplate {
+			continue
+		}
+
+		remoteRecord, err := c.FindBgpTemplate(rs.Primary.Attributes["name"])
+		if err != nil && !client.IsNotFoundError(err) {
+			return fmt.Errorf("expected not found error, got %+#v", err)
+		}
+
+		if remoteRecord != nil {
+			return fmt.Errorf("resource %T with id %q still exists in remote system", remoteRecord, remoteRecord.Id)
+		}
+	}
+	return nil
+}
+
+func testAccBgpTemplateConfig(name, holdTime string, multihop bool) string {
+	return fmt.Sprintf(`
+		resource "mikrotik_bgp_template" "testacc" {
+			name             = %q
+			as               = 65530
+			address_families = ["ip"]
+			hold_time        = %q
+			multihop         = %t
+		}
+	`, name, holdTime, multihop)
+}
@@ -0,0 +1,333 @@
+package mikrotik
+
+import (
+	"context"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/ddelnano/terraform-provider-mikrotik/mikrotik/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type certificate struct {
+	client *client.Mikrotik
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &certificate{}
+	_ resource.ResourceWithConfigure   = &certificate{}
+	_ resource.ResourceWithImportState = &certificate{}
+)
+
+// NewCertificateResource is a helper function to simplify the provider implementation.
+func NewCertificateResource() resource.Resource {
+	return &certificate{}
+}
+
+func (r *certificate) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*client.Mikrotik)
+}
+
+// Metadata returns the resource type name.
+func (r *certificate) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_certificate"
+}
+
+// certificateTemplateString returns schema of a string attribute, which is used to build certificate template.
+func certificateTemplateString(description string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+			stringplanmodifier.RequiresReplace(),
+		},
+		Validators: []validator.String{
+			stringvalidator.ConflictsWith(path.MatchRoot("certificate_pem")),
+		},
+		Description: description,
+	}
+}
+
+// certificateTemplateSet returns schema of a set attribute, which is used to build certificate template.
+func certificateTemplateSet(description string) schema.SetAttribute {
+	return schema.SetAttribute{
+		Optional:    true,
+		Computed:    true,
+		ElementType: tftypes.StringType,
+		PlanModifiers: []planmodifier.Set{
+			setplanmodifier.UseStateForUnknown(),
+			setplanmodifier.RequiresReplace(),
+		},
+		Validators: []validator.Set{
+			setvalidator.ConflictsWith(path.MatchRoot("certificate_pem")),
+		},
+		Description: description,
+	}
+}
+
+// certificateComputedString returns schema of a string attribute reported by RouterOS.
+func certificateComputedString(description string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+		Description: description,
+	}
+}
+
+// Schema defines the schema for the resource.
+func (s *certificate) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a certificate of the MikroTik device. The certificate is either created from a template and signed on the router, " +
+			"or imported from PEM encoded data. Importing requires RouterOS v7, since PEM data is uploaded as a file.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Unique ID of this resource.",
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Name of the certificate.",
+			},
+			"ca": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("certificate_pem")),
+				},
+				Description: "Name of the local CA certificate used to sign the certificate. If not set, the certificate is self-signed, e.g. to be used as a CA.",
+			},
+			"certificate_pem": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "PEM encoded certificate to import instead of creating one from a template. It must contain a single certificate, import each certificate of a chain as a separate resource.",
+			},
+			"common_name":  certificateTemplateString("Common name of the certificate subject."),
+			"country":      certificateTemplateString("Two letter country code of the certificate subject."),
+			"locality":     certificateTemplateString("Locality of the certificate subject."),
+			"organization": certificateTemplateString("Organization of the certificate subject."),
+			"state":        certificateTemplateString("State or province of the certificate subject."),
+			"unit":         certificateTemplateString("Organizational unit of the certificate subject."),
+			"key_size":     certificateTemplateString("Size of the generated key, e.g. `2048`, `4096` or an elliptic curve like `prime256v1`."),
+			"days_valid": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.ConflictsWith(path.MatchRoot("certificate_pem")),
+				},
+				Description: "Number of days the certificate is valid for.",
+			},
+			"key_usage":        certificateTemplateSet("Key usage extensions, e.g. `digital-signature`, `key-encipherment`, `key-cert-sign`, `crl-sign`, `tls-server` or `tls-client`."),
+			"subject_alt_name": certificateTemplateSet("Subject alternative names prefixed with the type, e.g. `DNS:router.example.com` or `IP:192.168.88.1`."),
+			"passphrase": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("private_key_pem")),
+				},
+				Description: "Passphrase of the encrypted private key.",
+			},
+			"private_key_pem": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("certificate_pem")),
+				},
+				Description: "PEM encoded private key of the imported certificate.",
+			},
+			"trusted": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the certificate is trusted, e.g. to verify peers.",
+			},
+			"fingerprint":    certificateComputedString("SHA-256 fingerprint of the certificate."),
+			"invalid_after":  certificateComputedString("Time the certificate expires at."),
+			"invalid_before": certificateComputedString("Time the certificate becomes valid at."),
+			"issuer":         certificateComputedString("Issuer of the certificate."),
+			"private_key": schema.BoolAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Whether the router holds the private key of the certificate.",
+			},
+			"serial_number": certificateComputedString("Serial number of the certificate."),
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *certificate) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var terraformModel certificateModel
+	var mikrotikModel client.Certificate
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &terraformModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := utils.TerraformModelToMikrotikStruct(ctx, &terraformModel, &mikrotikModel); err != nil {
+		resp.Diagnostics.AddError("Cannot copy model: Terraform -> MikroTik", err.Error())
+		return
+	}
+
+	var created *client.Certificate
+	var err error
+	if !terraformModel.CertificatePem.IsNull() {
+		created, err = r.client.ImportCertificate(
+			mikrotikModel.Name,
+			terraformModel.CertificatePem.ValueString(),
+			terraformModel.PrivateKeyPem.ValueString(),
+			terraformModel.Passphrase.ValueString(),
+		)
+		if err != nil {
+			resp.Diagnostics.AddError("Import failed", err.Error())
+			return
+		}
+	} else {
+		if _, err := r.client.AddCertificate(&mikrotikModel); err != nil {
+			resp.Diagnostics.AddError("Creation failed", err.Error())
+			return
+		}
+		created, err = r.client.SignCertificate(mikrotikModel.Name, terraformModel.Ca.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Signing failed", err.Error())
+			r.removeIncomplete(mikrotikModel.Name, &resp.Diagnostics)
+			return
+		}
+	}
+
+	// RouterOS marks self-signed certificates as trusted on its own.
+	if created.Trusted != mikrotikModel.Trusted {
+		created, err = r.client.SetCertificateTrusted(mikrotikModel.Name, mikrotikModel.Trusted)
+		if err != nil {
+			resp.Diagnostics.AddError("Creation failed", err.Error())
+			r.removeIncomplete(mikrotikModel.Name, &resp.Diagnostics)
+			return
+		}
+	}
+
+	if err := utils.MikrotikStructToTerraformModel(ctx, created, &terraformModel); err != nil {
+		resp.Diagnostics.AddError("Cannot copy model: MikroTik -> Terraform", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &terraformModel)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *certificate) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var terraformModel certificateModel
+	var mikrotikModel client.Certificate
+	GenericReadResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+// Update changes the trusted flag, since any other change requires replacement of the certificate.
+func (r *certificate) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var terraformModel certificateModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &terraformModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated, err := r.client.SetCertificateTrusted(terraformModel.Name.ValueString(), terraformModel.Trusted.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError("Update failed", err.Error())
+		return
+	}
+
+	if err := utils.MikrotikStructToTerraformModel(ctx, updated, &terraformModel); err != nil {
+		resp.Diagnostics.AddError("Cannot copy model: MikroTik -> Terraform", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &terraformModel)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *certificate) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var terraformModel certificateModel
+	var mikrotikModel client.Certificate
+	GenericDeleteResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+func (r *certificate) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
+
+// removeIncomplete removes the certificate which could not be created completely, so the next apply can start over.
+func (r *certificate) removeIncomplete(name string, diags *diag.Diagnostics) {
+	if err := r.client.DeleteCertificate(name); err != nil {
+		diags.AddWarning("Could not remove incomplete certificate", err.Error())
+	}
+}
+
+type certificateModel struct {
+	Id             tftypes.String `tfsdk:"id"`
+	Name           tftypes.String `tfsdk:"name"`
+	Ca             tftypes.String `tfsdk:"ca"`
+	CertificatePem tftypes.String `tfsdk:"certificate_pem"`
+	CommonName     tftypes.String `tfsdk:"common_name"`
+	Country        tftypes.String `tfsdk:"country"`
+	DaysValid      tftypes.Int64  `tfsdk:"days_valid"`
+	Fingerprint    tftypes.String `tfsdk:"fingerprint"`
+	InvalidAfter   tftypes.String `tfsdk:"invalid_after"`
+	InvalidBefore  tftypes.String `tfsdk:"invalid_before"`
+	Issuer         tftypes.String `tfsdk:"issuer"`
+	KeySize        tftypes.String `tfsdk:"key_size"`
+	KeyUsage       tftypes.Set    `tfsdk:"key_usage"`
+	Locality       tftypes.String `tfsdk:"locality"`
+	Organization   tftypes.String `tfsdk:"organization"`
+	Passphrase     tftypes.String `tfsdk:"passphrase"`
+	PrivateKey     tftypes.Bool   `tfsdk:"private_key"`
+	PrivateKeyPem  tftypes.String `tfsdk:"private_key_pem"`
+	SerialNumber   tftypes.String `tfsdk:"serial_number"`
+	State          tftypes.String `tfsdk:"state"`
+	SubjectAltName tftypes.Set    `tfsdk:"subject_alt_name"`
+	Trusted        tftypes.Bool   `tfsdk:"trusted"`
+	Unit           tftypes.String `tfsdk:"unit"`
+}
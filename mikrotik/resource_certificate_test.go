@@ -0,0 +1,176 @@
+package mikrotik
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var terraformResourceTypeCertificate string = "mikrotik_certificate"
+
+func TestCertificate_signWithLocalCA(t *testing.T) {
+	caResourceName := terraformResourceTypeCertificate + ".ca"
+	resourceName := terraformResourceTypeCertificate + ".server"
+	name := "testacc-" + client.RandomString()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCertificateConfig(name, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(caResourceName, "id"),
+					resource.TestCheckResourceAttr(caResourceName, "private_key", "true"),
+					resource.TestCheckResourceAttr(caResourceName, "trusted", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "common_name", name+".example.com"),
+					resource.TestCheckResourceAttrSet(resourceName, "issuer"),
+					resource.TestCheckResourceAttr(resourceName, "subject_alt_name.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "trusted", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "fingerprint"),
+				),
+			},
+			{
+				Config: testAccCertificateConfig(name, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "trusted", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateId:           name,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ca"},
+			},
+		},
+	})
+}
+
+func TestCertificate_importPem(t *testing.T) {
+	client.SkipIfRouterOSV6OrEarlier(t, sysResources)
+
+	resourceName := terraformResourceTypeCertificate + ".imported"
+	name := "testacc-" + client.RandomString()
+	certificatePem, privateKeyPem := generateTestCertificate(t, name+".example.com")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCertificateImportConfig(name, certificatePem, privateKeyPem, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "common_name", name+".example.com"),
+					resource.TestCheckResourceAttr(resourceName, "private_key", "true"),
+					resource.TestCheckResourceAttr(resourceName, "trusted", "false"),
+				),
+			},
+			{
+				Config: testAccCertificateImportConfig(name, certificatePem, privateKeyPem, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "trusted", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateId:           name,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"certificate_pem", "private_key_pem"},
+			},
+		},
+	})
+}
+
+func testAccCheckCertificateDestroy(s *terraform.State) error {
+	c := client.NewClient(client.GetConfigFromEnv())
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != terraformResourceTypeCertificate {
+			continue
+		}
+
+		remoteRecord, err := c.FindCertificate(rs.Primary.Attributes["name"])
+		if err != nil && !client.IsNotFoundError(err) {
+			return fmt.Errorf("expected not found error, got %+#v", err)
+		}
+
+		if remoteRecord != nil {
+			return fmt.Errorf("resource %T with id %q still exists in remote system", remoteRecord, remoteRecord.Id)
+		}
+	}
+	return nil
+}
+
+func testAccCertificateConfig(name string, trusted bool) string {
+	return fmt.Sprintf(`
+		resource "mikrotik_certificate" "ca" {
+			name        = "%[1]s-ca"
+			common_name = "%[1]s-ca"
+			key_usage   = ["key-cert-sign", "crl-sign"]
+		}
+
+		resource "mikrotik_certificate" "server" {
+			name             = %[1]q
+			ca               = mikrotik_certificate.ca.name
+			common_name      = "%[1]s.example.com"
+			days_valid       = 30
+			key_usage        = ["digital-signature", "key-encipherment", "tls-server"]
+			subject_alt_name = ["DNS:%[1]s.example.com", "IP:192.168.88.1"]
+			trusted          = %[2]t
+		}
+	`, name, trusted)
+}
+
+func testAccCertificateImportConfig(name, certificatePem, privateKeyPem string, trusted bool) string {
+	return fmt.Sprintf(`
+		resource "mikrotik_certificate" "imported" {
+			name            = %q
+			certificate_pem = %q
+			private_key_pem = %q
+			trusted         = %t
+		}
+	`, name, certificatePem, privateKeyPem, trusted)
+}
+
+// generateTestCertificate creates self-signed certificate and returns it along with its private key in PEM format.
+func generateTestCertificate(t *testing.T, commonName string) (string, string) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Error generating the key: %s", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		DNSNames:     []string{commonName},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("Error creating the certificate: %s", err)
+	}
+
+	certificatePem := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	privateKeyPem := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+
+	return string(certificatePem), string(privateKeyPem)
+}
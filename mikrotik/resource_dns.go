@@ -0,0 +1,155 @@
+package mikrotik
+
+import (
+	"context"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type dns struct {
+	client *client.Mikrotik
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &dns{}
+	_ resource.ResourceWithConfigure   = &dns{}
+	_ resource.ResourceWithImportState = &dns{}
+)
+
+// NewDnsResource is a helper function to simplify the provider implementation.
+func NewDnsResource() resource.Resource {
+	return &dns{}
+}
+
+func (r *dns) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*client.Mikrotik)
+}
+
+// Metadata returns the resource type name.
+func (r *dns) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns"
+}
+
+// Schema defines the schema for the resource.
+func (s *dns) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages DNS server settings of the MikroTik device. Destroying the resource restores default settings.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Unique ID of this resource.",
+			},
+			"servers": schema.ListAttribute{
+				Optional:    true,
+				Computed:    true,
+				ElementType: tftypes.StringType,
+				Description: "List of upstream DNS servers.",
+			},
+			"allow_remote_requests": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Allow the router to be used as a DNS server by remote clients.",
+			},
+			"cache_size": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(2048),
+				Description: "DNS cache size in KiB.",
+			},
+			"cache_max_ttl": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(604800),
+				Description: "Maximum time-to-live for cache records in seconds.",
+			},
+			"max_concurrent_queries": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(100),
+				Description: "Maximum number of concurrent DNS queries.",
+			},
+			"max_concurrent_tcp_sessions": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(20),
+				Description: "Maximum number of concurrent TCP sessions.",
+			},
+			"use_doh_server": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+				Description: "DNS over HTTPS server URL, e.g. `https://cloudflare-dns.com/dns-query`.",
+			},
+			"verify_doh_cert": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Verify certificate of the DNS over HTTPS server.",
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *dns) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var terraformModel dnsModel
+	var mikrotikModel client.DnsSettings
+	GenericCreateSingleton(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *dns) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var terraformModel dnsModel
+	var mikrotikModel client.DnsSettings
+	GenericReadSingleton(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *dns) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var terraformModel dnsModel
+	var mikrotikModel client.DnsSettings
+	GenericUpdateSingleton(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *dns) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var terraformModel dnsModel
+	var mikrotikModel client.DnsSettings
+	GenericDeleteSingleton(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+func (r *dns) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+type dnsModel struct {
+	Id                       tftypes.String `tfsdk:"id"`
+	Servers                  tftypes.List   `tfsdk:"servers"`
+	AllowRemoteRequests      tftypes.Bool   `tfsdk:"allow_remote_requests"`
+	CacheSize                tftypes.Int64  `tfsdk:"cache_size"`
+	CacheMaxTtl              tftypes.Int64  `tfsdk:"cache_max_ttl"`
+	MaxConcurrentQueries     tftypes.Int64  `tfsdk:"max_concurrent_queries"`
+	MaxConcurrentTcpSessions tftypes.Int64  `tfsdk:"max_concurrent_tcp_sessions"`
+	UseDohServer             tftypes.String `tfsdk:"use_doh_server"`
+	VerifyDohCert            tftypes.Bool   `tfsdk:"verify_doh_cert"`
+}
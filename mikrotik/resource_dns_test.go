@@ -0,0 +1,81 @@
+package mikrotik
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccMikrotikDns_basic(t *testing.T) {
+	resourceName := "mikrotik_dns.settings"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMikrotikDnsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDns(`["1.1.1.1", "8.8.8.8"]`, true, 4096),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "/ip/dns"),
+					resource.TestCheckResourceAttr(resourceName, "servers.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "servers.0", "1.1.1.1"),
+					resource.TestCheckResourceAttr(resourceName, "allow_remote_requests", "true"),
+					resource.TestCheckResourceAttr(resourceName, "cache_size", "4096"),
+				),
+			},
+			{
+				Config: testAccDns(`["9.9.9.9"]`, false, 2048),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "servers.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "servers.0", "9.9.9.9"),
+					resource.TestCheckResourceAttr(resourceName, "allow_remote_requests", "false"),
+					resource.TestCheckResourceAttr(resourceName, "cache_size", "2048"),
+				),
+			},
+			{
+				Config: testAccDns(`[]`, false, 2048),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "servers.#", "0"),
+				),
+			},
+			{
+				ImportState:       true,
+				ResourceName:      resourceName,
+				ImportStateId:     "/ip/dns",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDns(servers string, allowRemoteRequests bool, cacheSize int) string {
+	return fmt.Sprintf(`
+resource "mikrotik_dns" "settings" {
+    servers               = %s
+    allow_remote_requests = %t
+    cache_size            = %d
+}
+`, servers, allowRemoteRequests, cacheSize)
+}
+
+func testAccCheckMikrotikDnsDestroy(s *terraform.State) error {
+	c := client.NewClient(client.GetConfigFromEnv())
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "mikrotik_dns" {
+			continue
+		}
+
+		settings, err := c.FindDnsSettings()
+		if err != nil {
+			return err
+		}
+
+		if len(settings.Servers) > 0 || settings.AllowRemoteRequests {
+			return fmt.Errorf("dns settings were not restored to defaults: %+v", settings)
+		}
+	}
+	return nil
+}
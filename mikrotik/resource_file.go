@@ -0,0 +1,252 @@
+package mikrotik
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type file struct {
+	client *client.Mikrotik
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &file{}
+	_ resource.ResourceWithConfigure   = &file{}
+	_ resource.ResourceWithImportState = &file{}
+	_ resource.ResourceWithModifyPlan  = &file{}
+)
+
+// NewFileResource is a helper function to simplify the provider implementation.
+func NewFileResource() resource.Resource {
+	return &file{}
+}
+
+func (r *file) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*client.Mikrotik)
+}
+
+// Metadata returns the resource type name.
+func (r *file) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_file"
+}
+
+// Schema defines the schema for the resource.
+func (s *file) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a file stored on the MikroTik device. The contents are uploaded via the API, so neither FTP nor SFTP has to be enabled. " +
+			"Requires RouterOS v7, reading contents of files larger than 4 KiB requires RouterOS v7.13 or later.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Unique ID of this resource.",
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Name of the file, including the path, e.g. `flash/scripts/backup.rsc`.",
+			},
+			"contents": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+				Description: "Contents of the file. RouterOS cannot append to a file, so large contents are uploaded in parts to temporary files, which are joined on the router.",
+			},
+			"checksum": schema.StringAttribute{
+				Computed:    true,
+				Description: "SHA-256 checksum of the file contents on the router. It is used to detect changes made outside of Terraform.",
+			},
+			"size": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Size of the file in bytes.",
+			},
+			"type": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Type of the file as reported by RouterOS.",
+			},
+		},
+	}
+}
+
+// ModifyPlan sets the expected checksum, so contents changed outside of Terraform are planned to be overwritten.
+func (r *file) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var contents tftypes.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("contents"), &contents)...)
+	if resp.Diagnostics.HasError() || contents.IsUnknown() {
+		return
+	}
+
+	var checksum tftypes.String
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("checksum"), &checksum)...)
+	}
+	planned := tftypes.StringValue(fileChecksum(contents.ValueString()))
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("checksum"), planned)...)
+	if !checksum.Equal(planned) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("size"), tftypes.Int64Unknown())...)
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *file) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var terraformModel fileModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &terraformModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := r.client.AddFile(&client.File{
+		Name:     terraformModel.Name.ValueString(),
+		Contents: terraformModel.Contents.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Creation failed", err.Error())
+		return
+	}
+
+	r.setState(ctx, created.Name, &terraformModel, &resp.State, &resp.Diagnostics)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *file) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var terraformModel fileModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &terraformModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remote, err := r.client.FindFile(terraformModel.Name.ValueString())
+	if client.IsNotFoundError(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading remote resource", err.Error())
+		return
+	}
+
+	contents, err := r.readContents(remote)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading file contents", err.Error())
+		return
+	}
+
+	// Imported files have no contents in the state yet.
+	if terraformModel.Contents.IsNull() {
+		terraformModel.Contents = tftypes.StringValue(contents)
+	}
+	terraformModel.Id = tftypes.StringValue(remote.Id)
+	terraformModel.Checksum = tftypes.StringValue(fileChecksum(contents))
+	terraformModel.Size = tftypes.Int64Value(int64(remote.Size))
+	terraformModel.Type = tftypes.StringValue(remote.Type)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &terraformModel)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *file) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var terraformModel fileModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &terraformModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := terraformModel.Name.ValueString()
+	if err := r.client.SetFileContents(name, terraformModel.Contents.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Update failed", err.Error())
+		return
+	}
+
+	r.setState(ctx, name, &terraformModel, &resp.State, &resp.Diagnostics)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *file) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var terraformModel fileModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &terraformModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteFile(terraformModel.Name.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Delete failed", err.Error())
+		return
+	}
+}
+
+func (r *file) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
+
+// setState saves the written contents along with attributes reported by RouterOS.
+func (r *file) setState(ctx context.Context, name string, terraformModel *fileModel, state *tfsdk.State, diags *diag.Diagnostics) {
+	remote, err := r.client.FindFile(name)
+	if err != nil {
+		diags.AddError("Error reading remote resource", err.Error())
+		return
+	}
+
+	terraformModel.Id = tftypes.StringValue(remote.Id)
+	terraformModel.Checksum = tftypes.StringValue(fileChecksum(terraformModel.Contents.ValueString()))
+	terraformModel.Size = tftypes.Int64Value(int64(remote.Size))
+	terraformModel.Type = tftypes.StringValue(remote.Type)
+
+	diags.Append(state.Set(ctx, terraformModel)...)
+}
+
+// readContents returns full contents of the file, reading them in chunks if RouterOS does not report them.
+func (r *file) readContents(f *client.File) (string, error) {
+	if len(f.Contents) >= f.Size {
+		return f.Contents, nil
+	}
+
+	return r.client.ReadFileContents(f.Name, f.Size)
+}
+
+func fileChecksum(contents string) string {
+	sum := sha256.Sum256([]byte(contents))
+	return hex.EncodeToString(sum[:])
+}
+
+type fileModel struct {
+	Id       tftypes.String `tfsdk:"id"`
+	Name     tftypes.String `tfsdk:"name"`
+	Contents tftypes.String `tfsdk:"contents"`
+	Checksum tftypes.String `tfsdk:"checksum"`
+	Size     tftypes.Int64  `tfsdk:"size"`
+	Type     tftypes.String `tfsdk:"type"`
+}
@@ -0,0 +1,142 @@
+package mikrotik
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var terraformResourceTypeFile string = "mikrotik_file"
+
+func TestFile_basic(t *testing.T) {
+	client.SkipIfRouterOSV6OrEarlier(t, sysResources)
+
+	resourceName := terraformResourceTypeFile + ".testacc"
+	name := "testacc-" + client.RandomString() + ".txt"
+
+	changeContents := func() {
+		c := client.NewClient(client.GetConfigFromEnv())
+		if err := c.SetFileContents(name, "changed outside of terraform\n"); err != nil {
+			t.Fatalf("Error changing the file: %s", err)
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFileConfig(name, "first line\n"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "contents", "first line\n"),
+					resource.TestCheckResourceAttr(resourceName, "checksum", fileChecksum("first line\n")),
+					resource.TestCheckResourceAttr(resourceName, "size", "11"),
+				),
+			},
+			{
+				Config: testAccFileConfig(name, "second line\n"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "contents", "second line\n"),
+					resource.TestCheckResourceAttr(resourceName, "checksum", fileChecksum("second line\n")),
+					resource.TestCheckResourceAttr(resourceName, "size", "12"),
+				),
+			},
+			{
+				PreConfig: changeContents,
+				Config:    testAccFileConfig(name, "second line\n"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "checksum", fileChecksum("second line\n")),
+					resource.TestCheckResourceAttr(resourceName, "size", "12"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     name,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestFile_largeContents(t *testing.T) {
+	client.SkipIfRouterOSV6OrEarlier(t, sysResources)
+
+	resourceName := terraformResourceTypeFile + ".testacc"
+	name := "testacc-" + client.RandomString() + ".txt"
+	// larger than a single write and contents reported by /file/print,
+	// so they are uploaded in parts and read back via /file/read
+	contents := strings.Repeat("0123456789abcdef\n", 8000)
+
+	changeContents := func() {
+		c := client.NewClient(client.GetConfigFromEnv())
+		if err := c.SetFileContents(name, strings.Repeat("changed outside of terraform\n", 1000)); err != nil {
+			t.Fatalf("Error changing the file: %s", err)
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFileConfig(name, contents),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "checksum", fileChecksum(contents)),
+					resource.TestCheckResourceAttr(resourceName, "size", strconv.Itoa(len(contents))),
+				),
+			},
+			{
+				PreConfig: changeContents,
+				Config:    testAccFileConfig(name, contents),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "checksum", fileChecksum(contents)),
+					resource.TestCheckResourceAttr(resourceName, "size", strconv.Itoa(len(contents))),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     name,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckFileDestroy(s *terraform.State) error {
+	c := client.NewClient(client.GetConfigFromEnv())
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != terraformResourceTypeFile {
+			continue
+		}
+
+		remoteRecord, err := c.FindFile(rs.Primary.Attributes["name"])
+		if err != nil && !client.IsNotFoundError(err) {
+			return fmt.Errorf("expected not found error, got %+#v", err)
+		}
+
+		if remoteRecord != nil {
+			return fmt.Errorf("resource %T with id %q still exists in remote system", remoteRecord, remoteRecord.Id)
+		}
+	}
+	return nil
+}
+
+func testAccFileConfig(name, contents string) string {
+	return fmt.Sprintf(`
+		resource "mikrotik_file" "testacc" {
+			name     = %q
+			contents = %q
+		}
+	`, name, contents)
+}
@@ -0,0 +1,280 @@
+package mikrotik
+
+import (
+	"context"
+	"net"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type firewallAddressListBulk struct {
+	client *client.Mikrotik
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &firewallAddressListBulk{}
+	_ resource.ResourceWithConfigure   = &firewallAddressListBulk{}
+	_ resource.ResourceWithImportState = &firewallAddressListBulk{}
+)
+
+// NewFirewallAddressListBulkResource is a helper function to simplify the provider implementation.
+func NewFirewallAddressListBulkResource() resource.Resource {
+	return &firewallAddressListBulk{}
+}
+
+func (r *firewallAddressListBulk) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*client.Mikrotik)
+}
+
+// Metadata returns the resource type name.
+func (r *firewallAddressListBulk) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_firewall_address_list_bulk"
+}
+
+// Schema defines the schema for the resource.
+func (s *firewallAddressListBulk) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages all static entries of a MikroTik firewall address list as a set of addresses. Suitable for large lists, as the entries are read with a single request and changed in batches.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Unique ID of this resource.",
+			},
+			"addresses": schema.SetAttribute{
+				Required:    true,
+				ElementType: tftypes.StringType,
+				Description: "Addresses in the list, e.g. `10.0.0.1` or `10.0.0.0/24`. A host address with a full-length prefix, e.g. `10.0.0.1/32`, matches the entry RouterOS reports without the prefix.",
+			},
+			"comment": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+				Description: "Comment to set on every entry of the list.",
+			},
+			"list": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Name of the address list. Static entries of the list which are not in `addresses` are removed.",
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *firewallAddressListBulk) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var terraformModel firewallAddressListBulkModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &terraformModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if saveState := r.apply(ctx, &terraformModel, &resp.Diagnostics); !saveState {
+		return
+	}
+	terraformModel.Id = terraformModel.List
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &terraformModel)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *firewallAddressListBulk) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var terraformModel firewallAddressListBulkModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &terraformModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.readAddresses(ctx, &terraformModel, &resp.Diagnostics) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &terraformModel)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *firewallAddressListBulk) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var terraformModel firewallAddressListBulkModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &terraformModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if saveState := r.apply(ctx, &terraformModel, &resp.Diagnostics); !saveState {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &terraformModel)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *firewallAddressListBulk) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var terraformModel firewallAddressListBulkModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &terraformModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	terraformModel.Addresses = tftypes.SetValueMust(tftypes.StringType, nil)
+	if saveState := r.apply(ctx, &terraformModel, &resp.Diagnostics); saveState && resp.Diagnostics.HasError() {
+		// keep track of the entries which could not be removed
+		resp.Diagnostics.Append(resp.State.Set(ctx, &terraformModel)...)
+	}
+}
+
+func (r *firewallAddressListBulk) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("list"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("comment"), "")...)
+}
+
+// apply adds missing addresses to the list, removes the ones which are not in the model
+// and sets the comment of the model on the kept entries.
+//
+// If only some of the changes are applied, the addresses in the model are refreshed from the remote system.
+// The returned value reports whether the model matches the remote list, so it should be saved to the state.
+func (r *firewallAddressListBulk) apply(ctx context.Context, m *firewallAddressListBulkModel, diags *diag.Diagnostics) bool {
+	var desired []string
+	diags.Append(m.Addresses.ElementsAs(ctx, &desired, false)...)
+	if diags.HasError() {
+		return false
+	}
+
+	entries, err := r.client.ListFirewallAddressListEntries(m.List.ValueString())
+	if err != nil {
+		diags.AddError("Error reading remote resource", err.Error())
+		return false
+	}
+
+	wanted := map[string]bool{}
+	for _, address := range desired {
+		wanted[canonicalAddress(address)] = true
+	}
+	staleIDs := []string{}
+	commentIDs := []string{}
+	for _, e := range staticAddressListEntries(entries) {
+		address := canonicalAddress(e.Address)
+		if wanted[address] {
+			delete(wanted, address)
+			if e.Comment != m.Comment.ValueString() {
+				commentIDs = append(commentIDs, e.Id)
+			}
+			continue
+		}
+		staleIDs = append(staleIDs, e.Id)
+	}
+	missing := []client.FirewallAddressList{}
+	for _, address := range desired {
+		if wanted[canonicalAddress(address)] {
+			missing = append(missing, client.FirewallAddressList{
+				List:    m.List.ValueString(),
+				Address: address,
+				Comment: m.Comment.ValueString(),
+			})
+		}
+	}
+
+	if err := r.client.DeleteFirewallAddressListEntries(staleIDs); err != nil {
+		diags.AddError("Cannot remove address list entries", err.Error())
+	} else if err := r.client.SetFirewallAddressListEntriesComment(commentIDs, m.Comment.ValueString()); err != nil {
+		diags.AddError("Cannot set comment of address list entries", err.Error())
+	} else if err := r.client.AddFirewallAddressListEntries(missing); err != nil {
+		diags.AddError("Cannot add address list entries", err.Error())
+	}
+	if diags.HasError() {
+		return r.readAddresses(ctx, m, diags)
+	}
+
+	return true
+}
+
+// readAddresses sets addresses and comment of the model to static entries of the list on the remote system.
+//
+// Addresses already in the model keep their form, e.g. `10.0.0.1/32` stays as written although RouterOS reports `10.0.0.1`.
+func (r *firewallAddressListBulk) readAddresses(ctx context.Context, m *firewallAddressListBulkModel, diags *diag.Diagnostics) bool {
+	var known []string
+	if !m.Addresses.IsNull() && !m.Addresses.IsUnknown() {
+		diags.Append(m.Addresses.ElementsAs(ctx, &known, false)...)
+		if diags.HasError() {
+			return false
+		}
+	}
+	knownForms := map[string]string{}
+	for _, address := range known {
+		knownForms[canonicalAddress(address)] = address
+	}
+
+	entries, err := r.client.ListFirewallAddressListEntries(m.List.ValueString())
+	if err != nil {
+		diags.AddError("Error reading remote resource", err.Error())
+		return false
+	}
+
+	addresses := []string{}
+	for _, e := range staticAddressListEntries(entries) {
+		if e.Comment != m.Comment.ValueString() {
+			// report a differing comment, so the plan sets it on all the entries again
+			m.Comment = tftypes.StringValue(e.Comment)
+		}
+		address := e.Address
+		if form, ok := knownForms[canonicalAddress(address)]; ok {
+			address = form
+		}
+		addresses = append(addresses, address)
+	}
+	var d diag.Diagnostics
+	m.Addresses, d = tftypes.SetValueFrom(ctx, tftypes.StringType, addresses)
+	diags.Append(d...)
+
+	return !d.HasError()
+}
+
+// canonicalAddress returns the address without a full-length prefix, the way RouterOS reports host addresses.
+func canonicalAddress(address string) string {
+	ip, network, err := net.ParseCIDR(address)
+	if err != nil {
+		return address
+	}
+	if ones, bits := network.Mask.Size(); ones == bits {
+		return ip.String()
+	}
+
+	return address
+}
+
+// staticAddressListEntries filters out dynamic entries, e.g. the ones with timeout or added by firewall rules.
+func staticAddressListEntries(entries []client.FirewallAddressList) []client.FirewallAddressList {
+	result := []client.FirewallAddressList{}
+	for _, e := range entries {
+		if !e.Dynamic {
+			result = append(result, e)
+		}
+	}
+
+	return result
+}
+
+type firewallAddressListBulkModel struct {
+	Id        tftypes.String `tfsdk:"id"`
+	Addresses tftypes.Set    `tfsdk:"addresses"`
+	Comment   tftypes.String `tfsdk:"comment"`
+	List      tftypes.String `tfsdk:"list"`
+}
@@ -0,0 +1,107 @@
+package mikrotik
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestFirewallAddressListBulk_basic(t *testing.T) {
+	resourceName := "mikrotik_firewall_address_list_bulk.testacc"
+	list := "testacc-bulk-list"
+
+	initial := []string{}
+	for i := 1; i <= 300; i++ {
+		initial = append(initial, fmt.Sprintf("10.40.%d.%d", i/250, i%250))
+	}
+	updated := append([]string{}, initial[100:]...)
+	// host prefix of an existing entry does not replace it
+	updated[0] = updated[0] + "/32"
+	updated = append(updated, "192.168.100.0/24")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFirewallAddressListBulkEntries(list, 0),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallAddressListBulkConfig(list, "testacc", initial),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", list),
+					resource.TestCheckResourceAttr(resourceName, "addresses.#", "300"),
+					testAccCheckFirewallAddressListBulkEntries(list, 300),
+				),
+			},
+			{
+				Config: testAccFirewallAddressListBulkConfig(list, "testacc", updated),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "addresses.#", "201"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "addresses.*", "192.168.100.0/24"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "addresses.*", updated[0]),
+					testAccCheckFirewallAddressListBulkEntries(list, 201),
+				),
+			},
+			{
+				Config: testAccFirewallAddressListBulkConfig(list, "testacc-updated", updated),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "comment", "testacc-updated"),
+					testAccCheckFirewallAddressListBulkEntries(list, 201),
+					testAccCheckFirewallAddressListBulkComment(list, "testacc-updated"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// imported addresses are in the form RouterOS reports them
+				ImportStateVerifyIgnore: []string{"addresses"},
+			},
+		},
+	})
+}
+
+func testAccCheckFirewallAddressListBulkEntries(list string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		c := client.NewClient(client.GetConfigFromEnv())
+		entries, err := c.ListFirewallAddressListEntries(list)
+		if err != nil {
+			return err
+		}
+		if len(entries) != count {
+			return fmt.Errorf("expected %d entries in address list %q, got %d", count, list, len(entries))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckFirewallAddressListBulkComment(list, comment string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		c := client.NewClient(client.GetConfigFromEnv())
+		entries, err := c.ListFirewallAddressListEntries(list)
+		if err != nil {
+			return err
+		}
+		for _, e := range entries {
+			if e.Comment != comment {
+				return fmt.Errorf("expected comment %q of address %q, got %q", comment, e.Address, e.Comment)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccFirewallAddressListBulkConfig(list, comment string, addresses []string) string {
+	return fmt.Sprintf(`
+		resource "mikrotik_firewall_address_list_bulk" "testacc" {
+			list      = %q
+			comment   = %q
+			addresses = ["%s"]
+		}
+	`, list, comment, strings.Join(addresses, `", "`))
+}
@@ -0,0 +1,98 @@
+package mikrotik
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var terraformResourceTypeFirewallAddressList string = "mikrotik_firewall_address_list"
+
+func TestFirewallAddressList_basic(t *testing.T) {
+	resourceName := terraformResourceTypeFirewallAddressList + ".testacc"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFirewallAddressListDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallAddressListConfig("10.30.0.1", "first"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "list", "testacc-list"),
+					resource.TestCheckResourceAttr(resourceName, "address", "10.30.0.1"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "first"),
+				),
+			},
+			{
+				Config: testAccFirewallAddressListConfig("10.30.0.0/24", "second"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "address", "10.30.0.0/24"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "second"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestFirewallAddressList_timeout(t *testing.T) {
+	resourceName := terraformResourceTypeFirewallAddressList + ".testacc"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFirewallAddressListDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "mikrotik_firewall_address_list" "testacc" {
+						list    = "testacc-list"
+						address = "10.30.0.2"
+						timeout = 3600
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "timeout", "3600"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFirewallAddressListDestroy(s *terraform.State) error {
+	c := client.NewClient(client.GetConfigFromEnv())
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != terraformResourceTypeFirewallAddressList {
+			continue
+		}
+
+		remoteRecord, err := c.FindFirewallAddressList(rs.Primary.ID)
+		if err != nil && !client.IsNotFoundError(err) {
+			return fmt.Errorf("expected not found error, got %+#v", err)
+		}
+
+		if remoteRecord != nil {
+			return fmt.Errorf("resource %T with id %q still exists in remote system", remoteRecord, remoteRecord.Id)
+		}
+	}
+	return nil
+}
+
+func testAccFirewallAddressListConfig(address, comment string) string {
+	return fmt.Sprintf(`
+		resource "mikrotik_firewall_address_list" "testacc" {
+			list    = "testacc-list"
+			address = %q
+			comment = %q
+		}
+	`, address, comment)
+}
@@ -0,0 +1,160 @@
+package mikrotik
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// firewallMatcherAttributes returns attributes shared by rules of firewall filter, NAT, mangle and raw tables.
+func firewallMatcherAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"comment": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "Comment to the rule.",
+		},
+		"disabled": schema.BoolAttribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(false),
+			Description: "Whether the rule is disabled.",
+		},
+		"dst_address": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "Matches packets where destination is equal to specified IP or falls into specified IP range. Prefix the value with `!` to negate the matcher.",
+		},
+		"dst_address_list": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "Matches destination address of a packet against user-defined address list. Prefix the value with `!` to negate the matcher.",
+		},
+		"dst_port": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "List of destination port numbers or port number ranges.",
+		},
+		"icmp_options": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "Matches ICMP `type:code` fields. Prefix the value with `!` to negate the matcher.",
+		},
+		"in_interface": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "Interface the packet has entered the router. Prefix the value with `!` to negate the matcher.",
+		},
+		"in_interface_list": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "Set of interfaces defined in interface list. Works the same as in-interface.",
+		},
+		"jump_target": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "Name of the target chain to jump to. Applicable only if `action` is `jump`.",
+		},
+		"limit": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "Matches packets up to a limited rate (packet rate or bit rate) in format `count[/time],burst:mode`. Prefix the value with `!` to negate the matcher.",
+		},
+		"log": schema.BoolAttribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(false),
+			Description: "Add a message to the system log containing information about the matched packet.",
+		},
+		"log_prefix": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "Adds specified text at the beginning of every log message.",
+		},
+		"out_interface": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "Interface the packet is leaving the router. Prefix the value with `!` to negate the matcher.",
+		},
+		"out_interface_list": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "Set of interfaces defined in interface list. Works the same as out-interface.",
+		},
+		"src_address": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "Matches packets where source is equal to specified IP or falls into specified IP range. Prefix the value with `!` to negate the matcher.",
+		},
+		"src_address_list": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "Matches source address of a packet against user-defined address list. Prefix the value with `!` to negate the matcher.",
+		},
+		"src_port": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "List of source ports and ranges of source ports. Applicable only if protocol is TCP or UDP.",
+		},
+		"tcp_flags": schema.SetAttribute{
+			Optional:    true,
+			Computed:    true,
+			ElementType: tftypes.StringType,
+			Description: "Matches specified TCP flags. Prefix a flag with `!` to match packets without this flag, each flag is negated on its own, e.g. `[\"syn\", \"!ack\"]`.",
+		},
+	}
+}
+
+// firewallConnectionMatcherAttributes returns matchers which rely on connection tracking,
+// so they are not available in the raw table.
+func firewallConnectionMatcherAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"connection_mark": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "Matches packets marked via mangle facility with particular connection mark. If `no-mark` is set, rule will match any unmarked connection. Prefix the value with `!` to negate the matcher.",
+		},
+		"connection_nat_state": schema.SetAttribute{
+			Optional:    true,
+			Computed:    true,
+			ElementType: tftypes.StringType,
+			Description: "Matches connections that were subject to the `srcnat` and/or `dstnat`. Use `connection_nat_state_negate` to negate the matcher.",
+		},
+		"connection_nat_state_negate": schema.BoolAttribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(false),
+			Description: "Whether to negate the `connection_nat_state` matcher, i.e. match connections in none of the listed NAT states.",
+		},
+		"connection_state": schema.SetAttribute{
+			Optional:    true,
+			Computed:    true,
+			ElementType: tftypes.StringType,
+			Description: "Interprets the connection tracking analysis data for a particular packet. Use `connection_state_negate` to negate the matcher.",
+		},
+		"connection_state_negate": schema.BoolAttribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(false),
+			Description: "Whether to negate the `connection_state` matcher, i.e. match packets in none of the listed connection states.",
+		},
+		"packet_mark": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "Matches packets marked via mangle facility with particular packet mark. If `no-mark` is set, rule will match any unmarked packet. Prefix the value with `!` to negate the matcher.",
+		},
+	}
+}
+
+// mergeAttributes combines several attribute sets into single one.
+func mergeAttributes(sets ...map[string]schema.Attribute) map[string]schema.Attribute {
+	result := map[string]schema.Attribute{}
+	for _, set := range sets {
+		for name, attr := range set {
+			result[name] = attr
+		}
+	}
+
+	return result
+}
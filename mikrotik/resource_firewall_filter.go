@@ -48,62 +48,41 @@ func (r *firewallFilterRule) Metadata(_ context.Context, req resource.MetadataRe
 func (s *firewallFilterRule) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Creates a MikroTik FirewallFilterRule.",
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				Computed: true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
+		Attributes: mergeAttributes(
+			firewallMatcherAttributes(),
+			firewallConnectionMatcherAttributes(),
+			map[string]schema.Attribute{
+				"id": schema.StringAttribute{
+					Computed: true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+					Description: "Unique ID of this resource.",
+				},
+				"action": schema.StringAttribute{
+					Optional:    true,
+					Computed:    true,
+					Default:     stringdefault.StaticString("accept"),
+					Description: "Action to take if packet is matched by the rule.",
+				},
+				"chain": schema.StringAttribute{
+					Required:    true,
+					Description: "Specifies to which chain rule will be added. If the input does not match the name of an already defined chain, a new chain will be created.",
+				},
+				"place_before": placeBeforeAttribute(),
+				"protocol": schema.StringAttribute{
+					Optional:    true,
+					Computed:    true,
+					Default:     stringdefault.StaticString("tcp"),
+					Description: "Matches particular IP protocol specified by protocol name or number.",
+				},
+				"reject_with": schema.StringAttribute{
+					Optional:    true,
+					Computed:    true,
+					Description: "Specifies ICMP error to be sent back if packet is rejected. Applicable only if `action` is `reject`.",
 				},
-				Description: "Unique ID of this resource.",
-			},
-			"action": schema.StringAttribute{
-				Optional:    true,
-				Computed:    true,
-				Default:     stringdefault.StaticString("accept"),
-				Description: "Action to take if packet is matched by the rule.",
-			},
-			"chain": schema.StringAttribute{
-				Required:    true,
-				Description: "Specifies to which chain rule will be added. If the input does not match the name of an already defined chain, a new chain will be created.",
-			},
-			"comment": schema.StringAttribute{
-				Optional:    true,
-				Computed:    true,
-				Description: "Comment to the rule.",
-			},
-			"connection_state": schema.SetAttribute{
-				Optional:    true,
-				Computed:    true,
-				ElementType: tftypes.StringType,
-				Description: "Interprets the connection tracking analysis data for a particular packet.",
-			},
-			"dst_port": schema.StringAttribute{
-				Optional:    true,
-				Computed:    true,
-				Description: "List of destination port numbers or port number ranges.",
-			},
-			"in_interface": schema.StringAttribute{
-				Optional:    true,
-				Computed:    true,
-				Description: "Interface the packet has entered the router.",
-			},
-			"in_interface_list": schema.StringAttribute{
-				Optional:    true,
-				Computed:    true,
-				Description: "Set of interfaces defined in interface list. Works the same as in-interface.",
-			},
-			"out_interface_list": schema.StringAttribute{
-				Optional:    true,
-				Computed:    true,
-				Description: "Set of interfaces defined in interface list. Works the same as out-interface.",
-			},
-			"protocol": schema.StringAttribute{
-				Optional:    true,
-				Computed:    true,
-				Default:     stringdefault.StaticString("tcp"),
-				Description: "Matches particular IP protocol specified by protocol name or number.",
 			},
-		},
+		),
 	}
 }
 
@@ -112,6 +91,11 @@ func (r *firewallFilterRule) Create(ctx context.Context, req resource.CreateRequ
 	var terraformModel firewallFilterRuleModel
 	var mikrotikModel client.FirewallFilterRule
 	GenericCreateResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	applyPlaceBefore(r.client, &client.FirewallFilterRule{Id: terraformModel.Id.ValueString()}, terraformModel.PlaceBefore, &resp.Diagnostics)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -119,6 +103,11 @@ func (r *firewallFilterRule) Read(ctx context.Context, req resource.ReadRequest,
 	var terraformModel firewallFilterRuleModel
 	var mikrotikModel client.FirewallFilterRule
 	GenericReadResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	refreshPlaceBefore(ctx, r.client, &client.FirewallFilterRule{Id: terraformModel.Id.ValueString()}, terraformModel.PlaceBefore, &resp.State, &resp.Diagnostics)
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
@@ -126,6 +115,11 @@ func (r *firewallFilterRule) Update(ctx context.Context, req resource.UpdateRequ
 	var terraformModel firewallFilterRuleModel
 	var mikrotikModel client.FirewallFilterRule
 	GenericUpdateResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	applyPlaceBefore(r.client, &client.FirewallFilterRule{Id: terraformModel.Id.ValueString()}, terraformModel.PlaceBefore, &resp.Diagnostics)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -141,14 +135,34 @@ func (r *firewallFilterRule) ImportState(ctx context.Context, req resource.Impor
 }
 
 type firewallFilterRuleModel struct {
-	Id               tftypes.String `tfsdk:"id"`
-	Action           tftypes.String `tfsdk:"action"`
-	Chain            tftypes.String `tfsdk:"chain"`
-	Comment          tftypes.String `tfsdk:"comment"`
-	ConnectionState  tftypes.Set    `tfsdk:"connection_state"`
-	DestPort         tftypes.String `tfsdk:"dst_port"`
-	InInterface      tftypes.String `tfsdk:"in_interface"`
-	InInterfaceList  tftypes.String `tfsdk:"in_interface_list"`
-	OutInterfaceList tftypes.String `tfsdk:"out_interface_list"`
-	Protocol         tftypes.String `tfsdk:"protocol"`
+	Id                       tftypes.String `tfsdk:"id"`
+	Action                   tftypes.String `tfsdk:"action"`
+	Chain                    tftypes.String `tfsdk:"chain"`
+	Comment                  tftypes.String `tfsdk:"comment"`
+	ConnectionMark           tftypes.String `tfsdk:"connection_mark"`
+	ConnectionNatState       tftypes.Set    `tfsdk:"connection_nat_state"`
+	ConnectionNatStateNegate tftypes.Bool   `tfsdk:"connection_nat_state_negate"`
+	ConnectionState          tftypes.Set    `tfsdk:"connection_state"`
+	ConnectionStateNegate    tftypes.Bool   `tfsdk:"connection_state_negate"`
+	DestAddress              tftypes.String `tfsdk:"dst_address"`
+	DestAddressList          tftypes.String `tfsdk:"dst_address_list"`
+	DestPort                 tftypes.String `tfsdk:"dst_port"`
+	Disabled                 tftypes.Bool   `tfsdk:"disabled"`
+	IcmpOptions              tftypes.String `tfsdk:"icmp_options"`
+	InInterface              tftypes.String `tfsdk:"in_interface"`
+	InInterfaceList          tftypes.String `tfsdk:"in_interface_list"`
+	JumpTarget               tftypes.String `tfsdk:"jump_target"`
+	Limit                    tftypes.String `tfsdk:"limit"`
+	Log                      tftypes.Bool   `tfsdk:"log"`
+	LogPrefix                tftypes.String `tfsdk:"log_prefix"`
+	OutInterface             tftypes.String `tfsdk:"out_interface"`
+	OutInterfaceList         tftypes.String `tfsdk:"out_interface_list"`
+	PacketMark               tftypes.String `tfsdk:"packet_mark"`
+	PlaceBefore              tftypes.String `tfsdk:"place_before"`
+	Protocol                 tftypes.String `tfsdk:"protocol"`
+	RejectWith               tftypes.String `tfsdk:"reject_with"`
+	SrcAddress               tftypes.String `tfsdk:"src_address"`
+	SrcAddressList           tftypes.String `tfsdk:"src_address_list"`
+	SrcPort                  tftypes.String `tfsdk:"src_port"`
+	TcpFlags                 tftypes.Set    `tfsdk:"tcp_flags"`
 }
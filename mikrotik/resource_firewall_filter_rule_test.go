@@ -48,6 +48,185 @@ func TestFirewallFilterRule_basic(t *testing.T) {
 	})
 }
 
+func TestFirewallFilterRule_allMatchers(t *testing.T) {
+	resourceName := terraformResourceTypeFirewallFilterRule + ".testacc"
+	icmpResourceName := terraformResourceTypeFirewallFilterRule + ".testacc_icmp"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFirewallFilterRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "mikrotik_firewall_filter_rule" "testacc" {
+						action                      = "jump"
+						chain                       = "testChain"
+						jump_target                 = "testChain2"
+						connection_nat_state        = ["dstnat"]
+						connection_nat_state_negate = true
+						connection_state            = ["new", "invalid"]
+						connection_state_negate     = true
+						dst_address                 = "10.0.0.0/8"
+						dst_address_list            = "servers"
+						dst_port                    = "443"
+						src_address                 = "!192.168.88.0/24"
+						src_address_list            = "!trusted"
+						src_port                    = "1024-65535"
+						out_interface               = "ether2"
+						connection_mark             = "no-mark"
+						packet_mark                 = "no-mark"
+						limit                       = "10,5:packet"
+						tcp_flags                   = ["syn", "!ack"]
+						log                         = true
+						log_prefix                  = "tf-acc"
+						disabled                    = true
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "jump_target", "testChain2"),
+					resource.TestCheckResourceAttr(resourceName, "src_address", "!192.168.88.0/24"),
+					resource.TestCheckResourceAttr(resourceName, "src_address_list", "!trusted"),
+					resource.TestCheckResourceAttr(resourceName, "connection_nat_state_negate", "true"),
+					resource.TestCheckResourceAttr(resourceName, "connection_state.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "connection_state_negate", "true"),
+					resource.TestCheckResourceAttr(resourceName, "tcp_flags.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "log", "true"),
+					resource.TestCheckResourceAttr(resourceName, "disabled", "true"),
+				),
+			},
+			{
+				Config: `
+					resource "mikrotik_firewall_filter_rule" "testacc_icmp" {
+						action       = "reject"
+						chain        = "testChain"
+						protocol     = "icmp"
+						icmp_options = "8:0"
+						reject_with  = "icmp-admin-prohibited"
+						disabled     = true
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(icmpResourceName, "action", "reject"),
+					resource.TestCheckResourceAttr(icmpResourceName, "icmp_options", "8:0"),
+					resource.TestCheckResourceAttr(icmpResourceName, "reject_with", "icmp-admin-prohibited"),
+				),
+			},
+		},
+	})
+}
+
+func TestFirewallFilterRule_placeBefore(t *testing.T) {
+	acceptResourceName := terraformResourceTypeFirewallFilterRule + ".testacc_accept"
+	dropResourceName := terraformResourceTypeFirewallFilterRule + ".testacc_drop"
+	config := `
+		resource "mikrotik_firewall_filter_rule" "testacc_drop" {
+			action   = "drop"
+			chain    = "testChain"
+			disabled = true
+		}
+
+		resource "mikrotik_firewall_filter_rule" "testacc_accept" {
+			action       = "accept"
+			chain        = "testChain"
+			dst_port     = "22"
+			disabled     = true
+			place_before = mikrotik_firewall_filter_rule.testacc_drop.id
+		}
+	`
+
+	var acceptID, dropID string
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFirewallFilterRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(acceptResourceName, "place_before", dropResourceName, "id"),
+					testAccCheckFirewallFilterRuleOrder(acceptResourceName, dropResourceName),
+					func(s *terraform.State) error {
+						acceptID = s.RootModule().Resources[acceptResourceName].Primary.ID
+						dropID = s.RootModule().Resources[dropResourceName].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				// move the rule outside of Terraform and expect it to be placed back
+				PreConfig: func() {
+					c := client.NewClient(client.GetConfigFromEnv())
+					if err := c.MoveFirewallFilterRule(dropID, acceptID); err != nil {
+						t.Fatalf("cannot move firewall filter rule: %v", err)
+					}
+				},
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFirewallFilterRuleOrder(acceptResourceName, dropResourceName),
+				),
+			},
+		},
+	})
+}
+
+func TestFirewallFilterRule_placeBeforeMissingRule(t *testing.T) {
+	resourceName := terraformResourceTypeFirewallFilterRule + ".testacc"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFirewallFilterRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				// the missing destination must not cause a permanent diff, which is verified by the test framework after apply
+				Config: `
+					resource "mikrotik_firewall_filter_rule" "testacc" {
+						action       = "accept"
+						chain        = "testChain"
+						disabled     = true
+						place_before = "*FFFFFF"
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "place_before", "*FFFFFF"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckFirewallFilterRuleOrder ensures that the first rule precedes the second one on the remote system
+func testAccCheckFirewallFilterRuleOrder(first, second string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		firstRs, ok := s.RootModule().Resources[first]
+		if !ok {
+			return fmt.Errorf("resource %q not found in state", first)
+		}
+		secondRs, ok := s.RootModule().Resources[second]
+		if !ok {
+			return fmt.Errorf("resource %q not found in state", second)
+		}
+
+		c := client.NewClient(client.GetConfigFromEnv())
+		rules, err := c.ListFirewallFilterRules()
+		if err != nil {
+			return err
+		}
+
+		positions := map[string]int{}
+		for i, r := range rules {
+			positions[r.Id] = i
+		}
+		if positions[firstRs.Primary.ID] >= positions[secondRs.Primary.ID] {
+			return fmt.Errorf("expected rule %q to be placed before %q", firstRs.Primary.ID, secondRs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckFirewallFilterRuleDestroy(s *terraform.State) error {
 	c := client.NewClient(client.GetConfigFromEnv())
 	for _, rs := range s.RootModule().Resources {
@@ -0,0 +1,331 @@
+package mikrotik
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/ddelnano/terraform-provider-mikrotik/mikrotik/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type firewallFilterRuleset struct {
+	client *client.Mikrotik
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &firewallFilterRuleset{}
+	_ resource.ResourceWithConfigure   = &firewallFilterRuleset{}
+	_ resource.ResourceWithImportState = &firewallFilterRuleset{}
+)
+
+// NewFirewallFilterRulesetResource is a helper function to simplify the provider implementation.
+func NewFirewallFilterRulesetResource() resource.Resource {
+	return &firewallFilterRuleset{}
+}
+
+func (r *firewallFilterRuleset) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*client.Mikrotik)
+}
+
+// Metadata returns the resource type name.
+func (r *firewallFilterRuleset) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_firewall_filter_ruleset"
+}
+
+// Schema defines the schema for the resource.
+func (s *firewallFilterRuleset) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages all rules of a MikroTik firewall filter chain as an ordered list. Rules of the chain which are not listed in the configuration are removed.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Unique ID of this resource.",
+			},
+			"chain": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "The chain which rules are managed.",
+			},
+			"comment_prefix": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(""),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "If set, only rules of the chain with comment starting with this prefix are managed, the rest of the chain is left untouched. The prefix is prepended to comments of the managed rules.",
+			},
+			"rule_ids": schema.ListAttribute{
+				Computed:    true,
+				ElementType: tftypes.StringType,
+				Description: "IDs of the rules created or adopted by this resource, in the order they are placed on the router. Only these rules are removed when the resource is destroyed, so rules added to the chain later outside of Terraform are kept.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"rule": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: firewallFilterRulesetRuleAttributes(ctx),
+				},
+				Description: "Rules of the chain in the order they are placed on the router. The attributes are the same as in `mikrotik_firewall_filter_rule` resource. Omitted attributes are empty, except `action` which defaults to `accept`. Unlike in `mikrotik_firewall_filter_rule`, omitted `protocol` matches any protocol.",
+			},
+		},
+	}
+}
+
+// firewallFilterRulesetRuleAttributes derives attributes of a single rule from mikrotik_firewall_filter_rule schema.
+//
+// Attributes without default values get an empty one, so omitted attributes do not inherit values
+// of the rule which was previously located at the same position in the list.
+func firewallFilterRulesetRuleAttributes(ctx context.Context) map[string]schema.Attribute {
+	resp := resource.SchemaResponse{}
+	NewFirewallFilterRuleResource().Schema(ctx, resource.SchemaRequest{}, &resp)
+
+	attributes := map[string]schema.Attribute{}
+	for name, attr := range resp.Schema.Attributes {
+		switch name {
+		case "id", "chain", "place_before":
+			continue
+		}
+		switch a := attr.(type) {
+		case schema.StringAttribute:
+			// rules matching any protocol are common in a ruleset, e.g. a final catch-all drop rule
+			if a.Default == nil || name == "protocol" {
+				a.Default = stringdefault.StaticString("")
+			}
+			a.PlanModifiers = nil
+			attr = a
+		case schema.SetAttribute:
+			a.Computed = false
+			attr = a
+		}
+		attributes[name] = attr
+	}
+
+	return attributes
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *firewallFilterRuleset) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var terraformModel firewallFilterRulesetModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &terraformModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &terraformModel, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	terraformModel.Id = tftypes.StringValue(firewallFilterRulesetID(terraformModel.Chain.ValueString(), terraformModel.CommentPrefix.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &terraformModel)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *firewallFilterRuleset) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var terraformModel firewallFilterRulesetModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &terraformModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.read(ctx, &terraformModel, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &terraformModel)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *firewallFilterRuleset) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var terraformModel firewallFilterRulesetModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &terraformModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &terraformModel, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &terraformModel)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *firewallFilterRuleset) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var terraformModel firewallFilterRulesetModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &terraformModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := reconcileOrderedList(r.client, &client.FirewallFilterRule{}, terraformModel.tracks, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Cannot remove firewall filter rules", err.Error())
+	}
+}
+
+func (r *firewallFilterRuleset) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	chain, commentPrefix, _ := strings.Cut(req.ID, ":")
+	if chain == "" {
+		resp.Diagnostics.AddError("Invalid import ID", fmt.Sprintf("expected ID in form of 'chain' or 'chain:comment_prefix', got %q", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("chain"), chain)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("comment_prefix"), commentPrefix)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("rule"), []firewallFilterRulesetRuleModel{})...)
+}
+
+// apply reconciles the rules on the remote system with the model.
+//
+// The planned rules are kept in the model, only IDs of the rules are set.
+func (r *firewallFilterRuleset) apply(ctx context.Context, m *firewallFilterRulesetModel, diags *diag.Diagnostics) {
+	desired := make([]client.Resource, len(m.Rules))
+	for i := range m.Rules {
+		rule := client.FirewallFilterRule{}
+		if err := utils.TerraformModelToMikrotikStruct(ctx, &m.Rules[i], &rule); err != nil {
+			diags.AddError("Cannot copy model: Terraform -> MikroTik", err.Error())
+			return
+		}
+		rule.Chain = m.Chain.ValueString()
+		rule.Comment = m.CommentPrefix.ValueString() + rule.Comment
+		desired[i] = &rule
+	}
+
+	if err := reconcileOrderedList(r.client, &client.FirewallFilterRule{}, m.owns, desired); err != nil {
+		diags.AddError("Cannot apply firewall filter rules", err.Error())
+		return
+	}
+
+	ids := make([]string, len(desired))
+	for i, d := range desired {
+		ids[i] = d.ID()
+	}
+	var d diag.Diagnostics
+	m.RuleIds, d = tftypes.ListValueFrom(ctx, tftypes.StringType, ids)
+	diags.Append(d...)
+}
+
+// read replaces rules in the model with the ones found on the remote system.
+//
+// IDs of the rules which are no longer found are dropped from the model. All the found rules are tracked
+// only if the model tracks none yet, i.e. the resource is being imported.
+func (r *firewallFilterRuleset) read(ctx context.Context, m *firewallFilterRulesetModel, diags *diag.Diagnostics) {
+	found, err := listOwnedItems(r.client, &client.FirewallFilterRule{}, m.owns)
+	if err != nil {
+		diags.AddError("Error reading remote resource", err.Error())
+		return
+	}
+
+	trackAll := m.RuleIds.IsNull() || m.RuleIds.IsUnknown()
+	ids := []string{}
+	m.Rules = make([]firewallFilterRulesetRuleModel, len(found))
+	for i, f := range found {
+		if err := utils.MikrotikStructToTerraformModel(ctx, f, &m.Rules[i]); err != nil {
+			diags.AddError("Cannot copy model: MikroTik -> Terraform", err.Error())
+			return
+		}
+		m.Rules[i].Comment = tftypes.StringValue(strings.TrimPrefix(f.(*client.FirewallFilterRule).Comment, m.CommentPrefix.ValueString()))
+		if trackAll || m.tracks(f) {
+			ids = append(ids, f.ID())
+		}
+	}
+	var d diag.Diagnostics
+	m.RuleIds, d = tftypes.ListValueFrom(ctx, tftypes.StringType, ids)
+	diags.Append(d...)
+}
+
+func firewallFilterRulesetID(chain, commentPrefix string) string {
+	if commentPrefix == "" {
+		return chain
+	}
+
+	return chain + ":" + commentPrefix
+}
+
+type firewallFilterRulesetModel struct {
+	Id            tftypes.String                   `tfsdk:"id"`
+	Chain         tftypes.String                   `tfsdk:"chain"`
+	CommentPrefix tftypes.String                   `tfsdk:"comment_prefix"`
+	RuleIds       tftypes.List                     `tfsdk:"rule_ids"`
+	Rules         []firewallFilterRulesetRuleModel `tfsdk:"rule"`
+}
+
+// owns reports whether the rule is managed by the ruleset.
+func (m firewallFilterRulesetModel) owns(r client.Resource) bool {
+	rule := r.(*client.FirewallFilterRule)
+
+	return !rule.Dynamic &&
+		rule.Chain == m.Chain.ValueString() &&
+		strings.HasPrefix(rule.Comment, m.CommentPrefix.ValueString())
+}
+
+// tracks reports whether the rule is managed by the ruleset and its ID is known to the model.
+func (m firewallFilterRulesetModel) tracks(r client.Resource) bool {
+	if !m.owns(r) {
+		return false
+	}
+	var ids []string
+	m.RuleIds.ElementsAs(context.Background(), &ids, false)
+	for _, id := range ids {
+		if id == r.ID() {
+			return true
+		}
+	}
+
+	return false
+}
+
+type firewallFilterRulesetRuleModel struct {
+	Action                   tftypes.String `tfsdk:"action"`
+	Comment                  tftypes.String `tfsdk:"comment"`
+	ConnectionMark           tftypes.String `tfsdk:"connection_mark"`
+	ConnectionNatState       tftypes.Set    `tfsdk:"connection_nat_state"`
+	ConnectionNatStateNegate tftypes.Bool   `tfsdk:"connection_nat_state_negate"`
+	ConnectionState          tftypes.Set    `tfsdk:"connection_state"`
+	ConnectionStateNegate    tftypes.Bool   `tfsdk:"connection_state_negate"`
+	DestAddress              tftypes.String `tfsdk:"dst_address"`
+	DestAddressList          tftypes.String `tfsdk:"dst_address_list"`
+	DestPort                 tftypes.String `tfsdk:"dst_port"`
+	Disabled                 tftypes.Bool   `tfsdk:"disabled"`
+	IcmpOptions              tftypes.String `tfsdk:"icmp_options"`
+	InInterface              tftypes.String `tfsdk:"in_interface"`
+	InInterfaceList          tftypes.String `tfsdk:"in_interface_list"`
+	JumpTarget               tftypes.String `tfsdk:"jump_target"`
+	Limit                    tftypes.String `tfsdk:"limit"`
+	Log                      tftypes.Bool   `tfsdk:"log"`
+	LogPrefix                tftypes.String `tfsdk:"log_prefix"`
+	OutInterface             tftypes.String `tfsdk:"out_interface"`
+	OutInterfaceList         tftypes.String `tfsdk:"out_interface_list"`
+	PacketMark               tftypes.String `tfsdk:"packet_mark"`
+	Protocol                 tftypes.String `tfsdk:"protocol"`
+	RejectWith               tftypes.String `tfsdk:"reject_with"`
+	SrcAddress               tftypes.String `tfsdk:"src_address"`
+	SrcAddressList           tftypes.String `tfsdk:"src_address_list"`
+	SrcPort                  tftypes.String `tfsdk:"src_port"`
+	TcpFlags                 tftypes.Set    `tfsdk:"tcp_flags"`
+}
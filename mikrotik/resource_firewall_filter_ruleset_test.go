@@ -0,0 +1,236 @@
+package mikrotik
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestFirewallFilterRuleset_basic(t *testing.T) {
+	resourceName := "mikrotik_firewall_filter_ruleset.testacc"
+	chain := "testacc-ruleset"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFirewallFilterRulesetDestroy(chain),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallFilterRulesetConfig(chain, "", []string{"first", "second", "third"}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", chain),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "rule_ids.#", "3"),
+					testAccCheckFirewallFilterRulesetComments(chain, "first", "second", "third"),
+				),
+			},
+			{
+				Config: testAccFirewallFilterRulesetConfig(chain, "", []string{"third", "first", "fourth"}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.comment", "third"),
+					resource.TestCheckResourceAttr(resourceName, "rule.2.comment", "fourth"),
+					testAccCheckFirewallFilterRulesetComments(chain, "third", "first", "fourth"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestFirewallFilterRuleset_commentPrefix(t *testing.T) {
+	resourceName := "mikrotik_firewall_filter_ruleset.testacc"
+	chain := "testacc-ruleset"
+
+	c := client.NewClient(client.GetConfigFromEnv())
+	foreign, err := c.AddFirewallFilterRule(&client.FirewallFilterRule{Chain: chain, Comment: "foreign", Disabled: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := c.DeleteFirewallFilterRule(foreign.Id); err != nil {
+			t.Error(err)
+		}
+	}()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallFilterRulesetConfig(chain, "tf:", []string{"first", "second"}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", chain+":tf:"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.comment", "first"),
+					testAccCheckFirewallFilterRulesetComments(chain, "foreign", "tf:first", "tf:second"),
+				),
+			},
+			{
+				Config: testAccFirewallFilterRulesetConfig(chain, "tf:", []string{"second"}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+					testAccCheckFirewallFilterRulesetComments(chain, "foreign", "tf:second"),
+				),
+			},
+		},
+	})
+}
+
+func TestFirewallFilterRuleset_destroyKeepsUntrackedRules(t *testing.T) {
+	resourceName := "mikrotik_firewall_filter_ruleset.testacc"
+	chain := "testacc-ruleset"
+
+	c := client.NewClient(client.GetConfigFromEnv())
+	var added *client.FirewallFilterRule
+	addRule := func(s *terraform.State) error {
+		var err error
+		added, err = c.AddFirewallFilterRule(&client.FirewallFilterRule{Chain: chain, Comment: "added-later", Disabled: true})
+		return err
+	}
+	defer func() {
+		if added == nil {
+			return
+		}
+		if err := c.DeleteFirewallFilterRule(added.Id); err != nil {
+			t.Error(err)
+		}
+	}()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFirewallFilterRulesetComments(chain, "added-later"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallFilterRulesetConfig(chain, "", []string{"first", "second"}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rule_ids.#", "2"),
+					addRule,
+				),
+				// the rule added outside of Terraform is planned for removal, but it is not removed on destroy
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestFirewallFilterRuleset_anyProtocol(t *testing.T) {
+	resourceName := "mikrotik_firewall_filter_ruleset.testacc"
+	chain := "testacc-ruleset"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFirewallFilterRulesetDestroy(chain),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "mikrotik_firewall_filter_ruleset" "testacc" {
+						chain = %q
+
+						rule {
+							action   = "accept"
+							comment  = "ssh"
+							protocol = "tcp"
+							dst_port = "22"
+							disabled = true
+						}
+
+						rule {
+							action   = "drop"
+							comment  = "catch-all"
+							disabled = true
+						}
+					}
+				`, chain),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rule.0.protocol", "tcp"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.protocol", ""),
+					testAccCheckFirewallFilterRulesetComments(chain, "ssh", "catch-all"),
+					testAccCheckFirewallFilterRulesetProtocols(chain, "tcp", ""),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckFirewallFilterRulesetProtocols ensures that rules of the chain match given protocols in the same order
+func testAccCheckFirewallFilterRulesetProtocols(chain string, protocols ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		c := client.NewClient(client.GetConfigFromEnv())
+		rules, err := c.ListFirewallFilterRules()
+		if err != nil {
+			return err
+		}
+
+		actual := []string{}
+		for _, r := range rules {
+			if r.Chain == chain {
+				actual = append(actual, r.Protocol)
+			}
+		}
+		if strings.Join(actual, ",") != strings.Join(protocols, ",") {
+			return fmt.Errorf("expected protocols %q in chain %q, got %q", protocols, chain, actual)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckFirewallFilterRulesetComments ensures that the chain consists of rules with given comments in the same order
+func testAccCheckFirewallFilterRulesetComments(chain string, comments ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		c := client.NewClient(client.GetConfigFromEnv())
+		rules, err := c.ListFirewallFilterRules()
+		if err != nil {
+			return err
+		}
+
+		actual := []string{}
+		for _, r := range rules {
+			if r.Chain == chain {
+				actual = append(actual, r.Comment)
+			}
+		}
+		if strings.Join(actual, ",") != strings.Join(comments, ",") {
+			return fmt.Errorf("expected rules %q in chain %q, got %q", comments, chain, actual)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckFirewallFilterRulesetDestroy(chain string) resource.TestCheckFunc {
+	return testAccCheckFirewallFilterRulesetComments(chain)
+}
+
+func testAccFirewallFilterRulesetConfig(chain, commentPrefix string, comments []string) string {
+	rules := ""
+	for i, comment := range comments {
+		rules += fmt.Sprintf(`
+			rule {
+				action   = "accept"
+				comment  = %q
+				protocol = "tcp"
+				dst_port = "%d"
+				disabled = true
+			}
+		`, comment, 8000+i)
+	}
+
+	return fmt.Sprintf(`
+		resource "mikrotik_firewall_filter_ruleset" "testacc" {
+			chain          = %q
+			comment_prefix = %q
+			%s
+		}
+	`, chain, commentPrefix, rules)
+}
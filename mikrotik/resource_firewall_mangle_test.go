@@ -0,0 +1,169 @@
+package mikrotik
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var terraformResourceTypeFirewallMangleRule string = "mikrotik_firewall_mangle_rule"
+
+func TestFirewallMangleRule_markRouting(t *testing.T) {
+	resourceName := terraformResourceTypeFirewallMangleRule + ".testacc"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFirewallMangleRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallMangleRuleMarkRoutingConfig(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "action", "mark-routing"),
+					resource.TestCheckResourceAttr(resourceName, "new_routing_mark", "main"),
+					resource.TestCheckResourceAttr(resourceName, "src_address_list", "via-isp2"),
+					resource.TestCheckResourceAttr(resourceName, "passthrough", "true"),
+				),
+			},
+			{
+				Config: testAccFirewallMangleRuleMarkRoutingConfig(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "passthrough", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestFirewallMangleRule_changeMss(t *testing.T) {
+	resourceName := terraformResourceTypeFirewallMangleRule + ".testacc"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFirewallMangleRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "mikrotik_firewall_mangle_rule" "testacc" {
+						action        = "change-mss"
+						chain         = "forward"
+						new_mss       = "clamp-to-pmtu"
+						out_interface = "ether1"
+						protocol      = "tcp"
+						tcp_flags     = ["syn"]
+						disabled      = true
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "new_mss", "clamp-to-pmtu"),
+					resource.TestCheckResourceAttr(resourceName, "tcp_flags.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestFirewallMangleRule_accept(t *testing.T) {
+	resourceName := terraformResourceTypeFirewallMangleRule + ".testacc"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFirewallMangleRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallMangleRuleConfig("accept", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "action", "accept"),
+					resource.TestCheckResourceAttr(resourceName, "passthrough", "false"),
+				),
+			},
+			{
+				Config: testAccFirewallMangleRuleConfig("mark-packet", "testacc"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "action", "mark-packet"),
+					resource.TestCheckResourceAttr(resourceName, "passthrough", "true"),
+				),
+			},
+			{
+				Config: testAccFirewallMangleRuleConfig("accept", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "action", "accept"),
+					resource.TestCheckResourceAttr(resourceName, "passthrough", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: `
+					resource "mikrotik_firewall_mangle_rule" "testacc" {
+						action      = "accept"
+						chain       = "prerouting"
+						passthrough = true
+					}
+				`,
+				ExpectError: regexp.MustCompile("passthrough is applicable only to mark-\\* and change-\\*"),
+			},
+		},
+	})
+}
+
+func testAccCheckFirewallMangleRuleDestroy(s *terraform.State) error {
+	c := client.NewClient(client.GetConfigFromEnv())
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != terraformResourceTypeFirewallMangleRule {
+			continue
+		}
+
+		remoteRecord, err := c.FindFirewallMangleRule(rs.Primary.ID)
+		if err != nil && !client.IsNotFoundError(err) {
+			return fmt.Errorf("expected not found error, got %+#v", err)
+		}
+
+		if remoteRecord != nil {
+			return fmt.Errorf("resource %T with id %q still exists in remote system", remoteRecord, remoteRecord.Id)
+		}
+	}
+	return nil
+}
+
+func testAccFirewallMangleRuleMarkRoutingConfig(passthrough bool) string {
+	return fmt.Sprintf(`
+		resource "mikrotik_firewall_mangle_rule" "testacc" {
+			action           = "mark-routing"
+			chain            = "prerouting"
+			comment          = "testacc policy routing"
+			connection_state = ["new"]
+			new_routing_mark = "main"
+			src_address_list = "via-isp2"
+			passthrough      = %t
+			disabled         = true
+		}
+	`, passthrough)
+}
+
+func testAccFirewallMangleRuleConfig(action, newPacketMark string) string {
+	return fmt.Sprintf(`
+		resource "mikrotik_firewall_mangle_rule" "testacc" {
+			action          = %q
+			chain           = "prerouting"
+			new_packet_mark = %q
+			src_address     = "10.0.0.0/8"
+			disabled        = true
+		}
+	`, action, newPacketMark)
+}
@@ -0,0 +1,191 @@
+package mikrotik
+
+import (
+	"context"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/ddelnano/terraform-provider-mikrotik/mikrotik/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type firewallNatRule struct {
+	client *client.Mikrotik
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &firewallNatRule{}
+	_ resource.ResourceWithConfigure   = &firewallNatRule{}
+	_ resource.ResourceWithImportState = &firewallNatRule{}
+)
+
+// NewFirewallNatRuleResource is a helper function to simplify the provider implementation.
+func NewFirewallNatRuleResource() resource.Resource {
+	return &firewallNatRule{}
+}
+
+func (r *firewallNatRule) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*client.Mikrotik)
+}
+
+// Metadata returns the resource type name.
+func (r *firewallNatRule) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_firewall_nat_rule"
+}
+
+// Schema defines the schema for the resource.
+func (s *firewallNatRule) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates a MikroTik firewall NAT rule.",
+		Attributes: mergeAttributes(
+			firewallMatcherAttributes(),
+			firewallConnectionMatcherAttributes(),
+			map[string]schema.Attribute{
+				"id": schema.StringAttribute{
+					Computed: true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+					Description: "Unique ID of this resource.",
+				},
+				"action": schema.StringAttribute{
+					Optional:    true,
+					Computed:    true,
+					Default:     stringdefault.StaticString("accept"),
+					Description: "Action to take if packet is matched by the rule. Common values are `masquerade`, `src-nat`, `dst-nat`, `netmap`, `redirect` and `accept`.",
+				},
+				"chain": schema.StringAttribute{
+					Required:    true,
+					Description: "Specifies to which chain rule will be added. Use `srcnat` for source NAT and `dstnat` for destination NAT. If the input does not match the name of an already defined chain, a new chain will be created.",
+				},
+				"dst_address_type": schema.StringAttribute{
+					Optional:    true,
+					Computed:    true,
+					Description: "Matches destination address type, e.g. `local`, `unicast`, `broadcast` or `multicast`. Prefix the value with `!` to negate the matcher.",
+				},
+				"place_before": placeBeforeAttribute(),
+				"protocol": schema.StringAttribute{
+					Optional:    true,
+					Computed:    true,
+					Description: "Matches particular IP protocol specified by protocol name or number.",
+				},
+				"routing_mark": schema.StringAttribute{
+					Optional:    true,
+					Computed:    true,
+					Description: "Matches packets marked by mangle facility with particular routing mark. Prefix the value with `!` to negate the matcher.",
+				},
+				"src_address_type": schema.StringAttribute{
+					Optional:    true,
+					Computed:    true,
+					Description: "Matches source address type, e.g. `local`, `unicast`, `broadcast` or `multicast`. Prefix the value with `!` to negate the matcher.",
+				},
+				"to_addresses": schema.StringAttribute{
+					Optional:    true,
+					Computed:    true,
+					Description: "Address or address range to replace original address of the packet with. Applicable for `src-nat`, `dst-nat` and `netmap` actions.",
+				},
+				"to_ports": schema.StringAttribute{
+					Optional:    true,
+					Computed:    true,
+					Description: "Port or port range to replace original port of the packet with. Applicable for `src-nat`, `dst-nat`, `masquerade`, `netmap` and `redirect` actions.",
+				},
+			},
+		),
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *firewallNatRule) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var terraformModel firewallNatRuleModel
+	var mikrotikModel client.FirewallNatRule
+	GenericCreateResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	applyPlaceBefore(r.client, &client.FirewallNatRule{Id: terraformModel.Id.ValueString()}, terraformModel.PlaceBefore, &resp.Diagnostics)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *firewallNatRule) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var terraformModel firewallNatRuleModel
+	var mikrotikModel client.FirewallNatRule
+	GenericReadResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	refreshPlaceBefore(ctx, r.client, &client.FirewallNatRule{Id: terraformModel.Id.ValueString()}, terraformModel.PlaceBefore, &resp.State, &resp.Diagnostics)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *firewallNatRule) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var terraformModel firewallNatRuleModel
+	var mikrotikModel client.FirewallNatRule
+	GenericUpdateResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	applyPlaceBefore(r.client, &client.FirewallNatRule{Id: terraformModel.Id.ValueString()}, terraformModel.PlaceBefore, &resp.Diagnostics)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *firewallNatRule) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var terraformModel firewallNatRuleModel
+	var mikrotikModel client.FirewallNatRule
+	GenericDeleteResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+func (r *firewallNatRule) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	utils.ImportUppercaseWrapper(resource.ImportStatePassthroughID)(ctx, path.Root("id"), req, resp)
+}
+
+type firewallNatRuleModel struct {
+	Id                       tftypes.String `tfsdk:"id"`
+	Action                   tftypes.String `tfsdk:"action"`
+	Chain                    tftypes.String `tfsdk:"chain"`
+	Comment                  tftypes.String `tfsdk:"comment"`
+	ConnectionMark           tftypes.String `tfsdk:"connection_mark"`
+	ConnectionNatState       tftypes.Set    `tfsdk:"connection_nat_state"`
+	ConnectionNatStateNegate tftypes.Bool   `tfsdk:"connection_nat_state_negate"`
+	ConnectionState          tftypes.Set    `tfsdk:"connection_state"`
+	ConnectionStateNegate    tftypes.Bool   `tfsdk:"connection_state_negate"`
+	DestAddress              tftypes.String `tfsdk:"dst_address"`
+	DestAddressList          tftypes.String `tfsdk:"dst_address_list"`
+	DestAddressType          tftypes.String `tfsdk:"dst_address_type"`
+	DestPort                 tftypes.String `tfsdk:"dst_port"`
+	Disabled                 tftypes.Bool   `tfsdk:"disabled"`
+	IcmpOptions              tftypes.String `tfsdk:"icmp_options"`
+	InInterface              tftypes.String `tfsdk:"in_interface"`
+	InInterfaceList          tftypes.String `tfsdk:"in_interface_list"`
+	JumpTarget               tftypes.String `tfsdk:"jump_target"`
+	Limit                    tftypes.String `tfsdk:"limit"`
+	Log                      tftypes.Bool   `tfsdk:"log"`
+	LogPrefix                tftypes.String `tfsdk:"log_prefix"`
+	OutInterface             tftypes.String `tfsdk:"out_interface"`
+	OutInterfaceList         tftypes.String `tfsdk:"out_interface_list"`
+	PacketMark               tftypes.String `tfsdk:"packet_mark"`
+	PlaceBefore              tftypes.String `tfsdk:"place_before"`
+	Protocol                 tftypes.String `tfsdk:"protocol"`
+	RoutingMark              tftypes.String `tfsdk:"routing_mark"`
+	SrcAddress               tftypes.String `tfsdk:"src_address"`
+	SrcAddressList           tftypes.String `tfsdk:"src_address_list"`
+	SrcAddressType           tftypes.String `tfsdk:"src_address_type"`
+	SrcPort                  tftypes.String `tfsdk:"src_port"`
+	TcpFlags                 tftypes.Set    `tfsdk:"tcp_flags"`
+	ToAddresses              tftypes.String `tfsdk:"to_addresses"`
+	ToPorts                  tftypes.String `tfsdk:"to_ports"`
+}
@@ -0,0 +1,154 @@
+package mikrotik
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var terraformResourceTypeFirewallNatRule string = "mikrotik_firewall_nat_rule"
+
+func TestFirewallNatRule_portForward(t *testing.T) {
+	resourceName := terraformResourceTypeFirewallNatRule + ".testacc"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFirewallNatRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallNatRulePortForwardConfig("8080", "80"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "action", "dst-nat"),
+					resource.TestCheckResourceAttr(resourceName, "chain", "dstnat"),
+					resource.TestCheckResourceAttr(resourceName, "dst_port", "8080"),
+					resource.TestCheckResourceAttr(resourceName, "to_addresses", "192.168.88.10"),
+					resource.TestCheckResourceAttr(resourceName, "to_ports", "80"),
+					resource.TestCheckResourceAttr(resourceName, "tcp_flags.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "tcp_flags.*", "syn"),
+				),
+			},
+			{
+				Config: testAccFirewallNatRulePortForwardConfig("8443", "443"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "dst_port", "8443"),
+					resource.TestCheckResourceAttr(resourceName, "to_ports", "443"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"place_before"},
+			},
+		},
+	})
+}
+
+func TestFirewallNatRule_masqueradeWithPlaceBefore(t *testing.T) {
+	masqueradeResourceName := terraformResourceTypeFirewallNatRule + ".testacc_masquerade"
+	bypassResourceName := terraformResourceTypeFirewallNatRule + ".testacc_bypass"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFirewallNatRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "mikrotik_firewall_nat_rule" "testacc_masquerade" {
+						action             = "masquerade"
+						chain              = "srcnat"
+						out_interface_list = "all"
+						src_address_list   = "local"
+						disabled           = true
+					}
+
+					resource "mikrotik_firewall_nat_rule" "testacc_bypass" {
+						action           = "accept"
+						chain            = "srcnat"
+						dst_address_list = "vpn"
+						disabled         = true
+						place_before     = mikrotik_firewall_nat_rule.testacc_masquerade.id
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(masqueradeResourceName, "action", "masquerade"),
+					resource.TestCheckResourceAttr(masqueradeResourceName, "out_interface_list", "all"),
+					resource.TestCheckResourceAttrPair(bypassResourceName, "place_before", masqueradeResourceName, "id"),
+					testAccCheckFirewallNatRuleOrder(bypassResourceName, masqueradeResourceName),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckFirewallNatRuleOrder ensures that the first rule precedes the second one on the remote system
+func testAccCheckFirewallNatRuleOrder(first, second string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		firstRs, ok := s.RootModule().Resources[first]
+		if !ok {
+			return fmt.Errorf("resource %q not found in state", first)
+		}
+		secondRs, ok := s.RootModule().Resources[second]
+		if !ok {
+			return fmt.Errorf("resource %q not found in state", second)
+		}
+
+		c := client.NewClient(client.GetConfigFromEnv())
+		rules, err := c.ListFirewallNatRules()
+		if err != nil {
+			return err
+		}
+
+		positions := map[string]int{}
+		for i, r := range rules {
+			positions[r.Id] = i
+		}
+		if positions[firstRs.Primary.ID] >= positions[secondRs.Primary.ID] {
+			return fmt.Errorf("expected rule %q to be placed before %q", firstRs.Primary.ID, secondRs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckFirewallNatRuleDestroy(s *terraform.State) error {
+	c := client.NewClient(client.GetConfigFromEnv())
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != terraformResourceTypeFirewallNatRule {
+			continue
+		}
+
+		remoteRecord, err := c.FindFirewallNatRule(rs.Primary.ID)
+		if err != nil && !client.IsNotFoundError(err) {
+			return fmt.Errorf("expected not found error, got %+#v", err)
+		}
+
+		if remoteRecord != nil {
+			return fmt.Errorf("resource %T with id %q still exists in remote system", remoteRecord, remoteRecord.Id)
+		}
+	}
+	return nil
+}
+
+func testAccFirewallNatRulePortForwardConfig(dstPort, toPorts string) string {
+	return fmt.Sprintf(`
+		resource "mikrotik_firewall_nat_rule" "testacc" {
+			action            = "dst-nat"
+			chain             = "dstnat"
+			comment           = "testacc port forward"
+			dst_port          = %q
+			in_interface_list = "all"
+			protocol          = "tcp"
+			tcp_flags         = ["syn"]
+			to_addresses      = "192.168.88.10"
+			to_ports          = %q
+			disabled          = true
+		}
+	`, dstPort, toPorts)
+}
@@ -0,0 +1,154 @@
+package mikrotik
+
+import (
+	"context"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/ddelnano/terraform-provider-mikrotik/mikrotik/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type firewallRawRule struct {
+	client *client.Mikrotik
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &firewallRawRule{}
+	_ resource.ResourceWithConfigure   = &firewallRawRule{}
+	_ resource.ResourceWithImportState = &firewallRawRule{}
+)
+
+// NewFirewallRawRuleResource is a helper function to simplify the provider implementation.
+func NewFirewallRawRuleResource() resource.Resource {
+	return &firewallRawRule{}
+}
+
+func (r *firewallRawRule) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*client.Mikrotik)
+}
+
+// Metadata returns the resource type name.
+func (r *firewallRawRule) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_firewall_raw_rule"
+}
+
+// Schema defines the schema for the resource.
+func (s *firewallRawRule) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates a MikroTik firewall raw rule.",
+		Attributes: mergeAttributes(
+			firewallMatcherAttributes(),
+			map[string]schema.Attribute{
+				"id": schema.StringAttribute{
+					Computed: true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+					Description: "Unique ID of this resource.",
+				},
+				"action": schema.StringAttribute{
+					Optional:    true,
+					Computed:    true,
+					Default:     stringdefault.StaticString("accept"),
+					Description: "Action to take if packet is matched by the rule. Common values are `notrack`, `drop`, `accept` and `jump`.",
+				},
+				"chain": schema.StringAttribute{
+					Required:    true,
+					Description: "Specifies to which chain rule will be added: `prerouting`, `output` or a custom chain. If the input does not match the name of an already defined chain, a new chain will be created.",
+				},
+				"place_before": placeBeforeAttribute(),
+				"protocol": schema.StringAttribute{
+					Optional:    true,
+					Computed:    true,
+					Description: "Matches particular IP protocol specified by protocol name or number.",
+				},
+			},
+		),
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *firewallRawRule) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var terraformModel firewallRawRuleModel
+	var mikrotikModel client.FirewallRawRule
+	GenericCreateResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	applyPlaceBefore(r.client, &client.FirewallRawRule{Id: terraformModel.Id.ValueString()}, terraformModel.PlaceBefore, &resp.Diagnostics)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *firewallRawRule) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var terraformModel firewallRawRuleModel
+	var mikrotikModel client.FirewallRawRule
+	GenericReadResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	refreshPlaceBefore(ctx, r.client, &client.FirewallRawRule{Id: terraformModel.Id.ValueString()}, terraformModel.PlaceBefore, &resp.State, &resp.Diagnostics)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *firewallRawRule) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var terraformModel firewallRawRuleModel
+	var mikrotikModel client.FirewallRawRule
+	GenericUpdateResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	applyPlaceBefore(r.client, &client.FirewallRawRule{Id: terraformModel.Id.ValueString()}, terraformModel.PlaceBefore, &resp.Diagnostics)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *firewallRawRule) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var terraformModel firewallRawRuleModel
+	var mikrotikModel client.FirewallRawRule
+	GenericDeleteResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+func (r *firewallRawRule) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	utils.ImportUppercaseWrapper(resource.ImportStatePassthroughID)(ctx, path.Root("id"), req, resp)
+}
+
+type firewallRawRuleModel struct {
+	Id               tftypes.String `tfsdk:"id"`
+	Action           tftypes.String `tfsdk:"action"`
+	Chain            tftypes.String `tfsdk:"chain"`
+	Comment          tftypes.String `tfsdk:"comment"`
+	DestAddress      tftypes.String `tfsdk:"dst_address"`
+	DestAddressList  tftypes.String `tfsdk:"dst_address_list"`
+	DestPort         tftypes.String `tfsdk:"dst_port"`
+	Disabled         tftypes.Bool   `tfsdk:"disabled"`
+	IcmpOptions      tftypes.String `tfsdk:"icmp_options"`
+	InInterface      tftypes.String `tfsdk:"in_interface"`
+	InInterfaceList  tftypes.String `tfsdk:"in_interface_list"`
+	JumpTarget       tftypes.String `tfsdk:"jump_target"`
+	Limit            tftypes.String `tfsdk:"limit"`
+	Log              tftypes.Bool   `tfsdk:"log"`
+	LogPrefix        tftypes.String `tfsdk:"log_prefix"`
+	OutInterface     tftypes.String `tfsdk:"out_interface"`
+	OutInterfaceList tftypes.String `tfsdk:"out_interface_list"`
+	PlaceBefore      tftypes.String `tfsdk:"place_before"`
+	Protocol         tftypes.String `tfsdk:"protocol"`
+	SrcAddress       tftypes.String `tfsdk:"src_address"`
+	SrcAddressList   tftypes.String `tfsdk:"src_address_list"`
+	SrcPort          tftypes.String `tfsdk:"src_port"`
+	TcpFlags         tftypes.Set    `tfsdk:"tcp_flags"`
+}
@@ -0,0 +1,76 @@
+package mikrotik
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var terraformResourceTypeFirewallRawRule string = "mikrotik_firewall_raw_rule"
+
+func TestFirewallRawRule_basic(t *testing.T) {
+	resourceName := terraformResourceTypeFirewallRawRule + ".testacc"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFirewallRawRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallRawRuleConfig("drop", "blacklist"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "action", "drop"),
+					resource.TestCheckResourceAttr(resourceName, "chain", "prerouting"),
+					resource.TestCheckResourceAttr(resourceName, "src_address_list", "blacklist"),
+				),
+			},
+			{
+				Config: testAccFirewallRawRuleConfig("notrack", "trusted"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "action", "notrack"),
+					resource.TestCheckResourceAttr(resourceName, "src_address_list", "trusted"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckFirewallRawRuleDestroy(s *terraform.State) error {
+	c := client.NewClient(client.GetConfigFromEnv())
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != terraformResourceTypeFirewallRawRule {
+			continue
+		}
+
+		remoteRecord, err := c.FindFirewallRawRule(rs.Primary.ID)
+		if err != nil && !client.IsNotFoundError(err) {
+			return fmt.Errorf("expected not found error, got %+#v", err)
+		}
+
+		if remoteRecord != nil {
+			return fmt.Errorf("resource %T with id %q still exists in remote system", remoteRecord, remoteRecord.Id)
+		}
+	}
+	return nil
+}
+
+func testAccFirewallRawRuleConfig(action, srcAddressList string) string {
+	return fmt.Sprintf(`
+		resource "mikrotik_firewall_raw_rule" "testacc" {
+			action           = %q
+			chain            = "prerouting"
+			in_interface     = "ether1"
+			src_address_list = %q
+			disabled         = true
+		}
+	`, action, srcAddressList)
+}
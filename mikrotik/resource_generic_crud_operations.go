@@ -2,10 +2,18 @@ package mikrotik
 
 import (
 	"context"
+	"reflect"
+	"strings"
 
 	"github.com/ddelnano/terraform-provider-mikrotik/client"
 	"github.com/ddelnano/terraform-provider-mikrotik/mikrotik/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 type (
@@ -129,3 +137,166 @@ func GenericDeleteResource(terraformModel interface{}, mikrotikModel client.Reso
 		}
 	}
 }
+
+// GenericCreateSingleton adopts the singleton menu, applies planned settings and sets the initial Terraform state.
+//
+// Singleton menus cannot be added, so creation is a plain `set` command.
+// The `id` attribute is populated with the menu path.
+func GenericCreateSingleton(terraformModel interface{}, mikrotikModel client.Singleton, client *client.Mikrotik) CreateFunc {
+	return func(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+		resp.Diagnostics.Append(req.Plan.Get(ctx, terraformModel)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		current, err := client.FindSingleton(mikrotikModel)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading remote resource", err.Error())
+			return
+		}
+		if err := fillUnknownSettings(ctx, terraformModel, current); err != nil {
+			resp.Diagnostics.AddError("Cannot copy model: MikroTik -> Terraform", err.Error())
+			return
+		}
+		if err := utils.TerraformModelToMikrotikSingleton(ctx, terraformModel, mikrotikModel); err != nil {
+			resp.Diagnostics.AddError("Cannot copy model: Terraform -> MikroTik", err.Error())
+			return
+		}
+
+		updated, err := client.UpdateSingleton(mikrotikModel)
+		if err != nil {
+			resp.Diagnostics.AddError("Creation failed", err.Error())
+			return
+		}
+		if err := utils.MikrotikSingletonToTerraformModel(ctx, updated, terraformModel); err != nil {
+			resp.Diagnostics.AddError("Cannot copy model: MikroTik -> Terraform", err.Error())
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, terraformModel)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), singletonID(mikrotikModel))...)
+	}
+}
+
+// GenericReadSingleton refreshes the Terraform state with the latest settings of the singleton menu.
+func GenericReadSingleton(terraformModel interface{}, mikrotikModel client.Singleton, client *client.Mikrotik) ReadFunc {
+	return func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+		resp.Diagnostics.Append(req.State.Get(ctx, terraformModel)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		found, err := client.FindSingleton(mikrotikModel)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading remote resource",
+				err.Error(),
+			)
+			return
+		}
+		if err := utils.MikrotikSingletonToTerraformModel(ctx, found, terraformModel); err != nil {
+			resp.Diagnostics.AddError("Cannot copy model: MikroTik -> Terraform", err.Error())
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, terraformModel)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), singletonID(mikrotikModel))...)
+	}
+}
+
+// GenericUpdateSingleton applies settings of the singleton menu and sets the updated Terraform state on success.
+func GenericUpdateSingleton(terraformModel interface{}, mikrotikModel client.Singleton, client *client.Mikrotik) UpdateFunc {
+	return func(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+		resp.Diagnostics.Append(req.Plan.Get(ctx, terraformModel)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		current, err := client.FindSingleton(mikrotikModel)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading remote resource", err.Error())
+			return
+		}
+		if err := fillUnknownSettings(ctx, terraformModel, current); err != nil {
+			resp.Diagnostics.AddError("Cannot copy model: MikroTik -> Terraform", err.Error())
+			return
+		}
+		if err := utils.TerraformModelToMikrotikSingleton(ctx, terraformModel, mikrotikModel); err != nil {
+			resp.Diagnostics.AddError("Cannot copy model: Terraform -> MikroTik", err.Error())
+			return
+		}
+		updated, err := client.UpdateSingleton(mikrotikModel)
+		if err != nil {
+			resp.Diagnostics.AddError("Update failed", err.Error())
+			return
+		}
+		if err := utils.MikrotikSingletonToTerraformModel(ctx, updated, terraformModel); err != nil {
+			resp.Diagnostics.AddError("Cannot copy model: MikroTik -> Terraform", err.Error())
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, terraformModel)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), singletonID(mikrotikModel))...)
+	}
+}
+
+// GenericDeleteSingleton restores default settings of the singleton menu, if supported, and removes the Terraform state.
+func GenericDeleteSingleton(terraformModel interface{}, mikrotikModel client.Singleton, client *client.Mikrotik) DeleteFunc {
+	return func(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+		resp.Diagnostics.Append(req.State.Get(ctx, terraformModel)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if err := client.ResetSingleton(mikrotikModel); err != nil {
+			resp.Diagnostics.AddError("Could not restore default settings of MikroTik resource", err.Error())
+			return
+		}
+	}
+}
+
+// fillUnknownSettings replaces values unknown in the plan with current settings of the singleton menu,
+// so settings which are not configured keep their values when the whole menu is sent to the router.
+func fillUnknownSettings(ctx context.Context, terraformModel interface{}, current client.Singleton) error {
+	planned := reflect.ValueOf(terraformModel).Elem()
+	remote := reflect.New(planned.Type())
+	if err := utils.MikrotikSingletonToTerraformModel(ctx, current, remote.Interface()); err != nil {
+		return err
+	}
+
+	for i := 0; i < planned.NumField(); i++ {
+		value, ok := planned.Field(i).Interface().(attr.Value)
+		if !ok || !value.IsUnknown() {
+			continue
+		}
+		if remoteValue := remote.Elem().Field(i).Interface().(attr.Value); !remoteValue.IsNull() {
+			planned.Field(i).Set(remote.Elem().Field(i))
+		}
+	}
+
+	return nil
+}
+
+// singletonID builds stable resource ID from the menu path, e.g. `/ip/dns`.
+func singletonID(s client.Singleton) string {
+	return strings.TrimSuffix(s.ActionToCommand(client.Find), "/print")
+}
+
+// keepWriteOnlyAttribute copies value of the string attribute, which RouterOS never reports back, from src to the state.
+//
+// src is the plan on create and update, and the prior state on read.
+func keepWriteOnlyAttribute(ctx context.Context, p path.Path, src interface {
+	GetAttribute(context.Context, path.Path, interface{}) diag.Diagnostics
+}, state *tfsdk.State) diag.Diagnostics {
+	if state.Raw.IsNull() {
+		// resource was removed from the state
+		return nil
+	}
+
+	var value tftypes.String
+	diags := src.GetAttribute(ctx, p, &value)
+	if diags.HasError() {
+		return diags
+	}
+	diags.Append(state.SetAttribute(ctx, p, value)...)
+
+	return diags
+}
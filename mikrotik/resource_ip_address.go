@@ -81,6 +81,14 @@ func (s *ipAddress) Schema(_ context.Context, _ resource.SchemaRequest, resp *re
 				},
 				Description: "IP address for the network.",
 			},
+			"vrf": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Name of the VRF the address belongs to. If unset, the VRF of the interface is used. Supported by RouterOS v7+ only.",
+			},
 		},
 	}
 }
@@ -125,4 +133,5 @@ type ipAddressModel struct {
 	Disabled  tftypes.Bool   `tfsdk:"disabled"`
 	Interface tftypes.String `tfsdk:"interface"`
 	Network   tftypes.String `tfsdk:"network"`
+	Vrf       tftypes.String `tfsdk:"vrf"`
 }
@@ -95,6 +95,53 @@ func TestAccMikrotikResourceIpAddress_updateAddr(t *testing.T) {
 	})
 }
 
+func TestAccMikrotikResourceIpAddress_vrf(t *testing.T) {
+	client.SkipIfRouterOSV6OrEarlier(t, sysResources)
+	ipAddr := internal.GetNewIpAddr() + "/24"
+	name := acctest.RandomWithPrefix("tf-acc-vrf")
+
+	resourceName := "mikrotik_ip_address.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckMikrotikIpAddressDestroy,
+			testAccCheckVrfDestroy,
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "mikrotik_bridge" "testacc" {
+						name = %[1]q
+					}
+
+					resource "mikrotik_vrf" "testacc" {
+						name       = %[1]q
+						interfaces = [mikrotik_bridge.testacc.name]
+					}
+
+					resource "mikrotik_ip_address" "test" {
+						address   = %[2]q
+						interface = mikrotik_bridge.testacc.name
+						vrf       = mikrotik_vrf.testacc.name
+					}
+				`, name, ipAddr),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccIpAddressExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "address", ipAddr),
+					resource.TestCheckResourceAttr(resourceName, "interface", name),
+					resource.TestCheckResourceAttr(resourceName, "vrf", name),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccIpAddress(ipAddr, ifName, comment string) string {
 	return fmt.Sprintf(`
 resource "mikrotik_ip_address" "test" {
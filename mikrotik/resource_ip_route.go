@@ -0,0 +1,187 @@
+package mikrotik
+
+import (
+	"context"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/ddelnano/terraform-provider-mikrotik/mikrotik/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ipRoute struct {
+	client *client.Mikrotik
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &ipRoute{}
+	_ resource.ResourceWithConfigure   = &ipRoute{}
+	_ resource.ResourceWithImportState = &ipRoute{}
+)
+
+// NewIpRouteResource is a helper function to simplify the provider implementation.
+func NewIpRouteResource() resource.Resource {
+	return &ipRoute{}
+}
+
+func (r *ipRoute) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*client.Mikrotik)
+}
+
+// Metadata returns the resource type name.
+func (r *ipRoute) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ip_route"
+}
+
+// Schema defines the schema for the resource.
+func (s *ipRoute) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates a MikroTik static IPv4 route.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Unique ID of this resource.",
+			},
+			"blackhole": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+				Description: "Whether matching packets are silently discarded. Supported on RouterOS v7 only, use `type` on RouterOS v6.",
+			},
+			"check_gateway": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Periodically check reachability of the gateway: `ping`, `arp` or `bfd`.",
+			},
+			"comment": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Comment to the route.",
+			},
+			"disabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the route is disabled.",
+			},
+			"distance": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Administrative distance of the route. Routes with lower distance are preferred.",
+			},
+			"dst_address": schema.StringAttribute{
+				Required:    true,
+				Description: "Destination prefix of the route, e.g. `0.0.0.0/0` for the default route.",
+			},
+			"gateway": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Gateway address, interface name or `address%interface` to use for the route.",
+			},
+			"pref_src": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Source address preferred for packets leaving the router via this route.",
+			},
+			"routing_mark": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Routing mark selecting the routing table the route belongs to. Supported on RouterOS v6 only.",
+			},
+			"routing_table": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Routing table the route belongs to. Supported on RouterOS v7 only.",
+			},
+			"scope": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Scope of the route used for recursive next-hop lookup.",
+			},
+			"target_scope": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Maximum scope of routes which may be used to resolve the gateway.",
+			},
+			"type": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Type of the route: `unicast`, `blackhole`, `prohibit` or `unreachable`. Supported on RouterOS v6 only.",
+			},
+			"vrf_interface": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "VRF interface to resolve the gateway in. Supported on RouterOS v7 only.",
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *ipRoute) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var terraformModel ipRouteModel
+	var mikrotikModel client.IpRoute
+	GenericCreateResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ipRoute) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var terraformModel ipRouteModel
+	var mikrotikModel client.IpRoute
+	GenericReadResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *ipRoute) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var terraformModel ipRouteModel
+	var mikrotikModel client.IpRoute
+	GenericUpdateResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *ipRoute) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var terraformModel ipRouteModel
+	var mikrotikModel client.IpRoute
+	GenericDeleteResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+func (r *ipRoute) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	utils.ImportUppercaseWrapper(resource.ImportStatePassthroughID)(ctx, path.Root("id"), req, resp)
+}
+
+type ipRouteModel struct {
+	Id           tftypes.String `tfsdk:"id"`
+	Blackhole    tftypes.Bool   `tfsdk:"blackhole"`
+	CheckGateway tftypes.String `tfsdk:"check_gateway"`
+	Comment      tftypes.String `tfsdk:"comment"`
+	Disabled     tftypes.Bool   `tfsdk:"disabled"`
+	Distance     tftypes.Int64  `tfsdk:"distance"`
+	DstAddress   tftypes.String `tfsdk:"dst_address"`
+	Gateway      tftypes.String `tfsdk:"gateway"`
+	PrefSrc      tftypes.String `tfsdk:"pref_src"`
+	RoutingMark  tftypes.String `tfsdk:"routing_mark"`
+	RoutingTable tftypes.String `tfsdk:"routing_table"`
+	Scope        tftypes.Int64  `tfsdk:"scope"`
+	TargetScope  tftypes.Int64  `tfsdk:"target_scope"`
+	Type         tftypes.String `tfsdk:"type"`
+	VrfInterface tftypes.String `tfsdk:"vrf_interface"`
+}
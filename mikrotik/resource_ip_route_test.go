@@ -0,0 +1,102 @@
+package mikrotik
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var terraformResourceTypeIpRoute string = "mikrotik_ip_route"
+
+func TestIpRoute_basic(t *testing.T) {
+	resourceName := terraformResourceTypeIpRoute + ".testacc"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIpRouteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIpRouteConfig("192.168.88.254", 5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "dst_address", "10.78.0.0/24"),
+					resource.TestCheckResourceAttr(resourceName, "gateway", "192.168.88.254"),
+					resource.TestCheckResourceAttr(resourceName, "distance", "5"),
+				),
+			},
+			{
+				Config: testAccIpRouteConfig("192.168.88.253", 10),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "gateway", "192.168.88.253"),
+					resource.TestCheckResourceAttr(resourceName, "distance", "10"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestIpRoute_blackhole(t *testing.T) {
+	client.SkipIfRouterOSV6OrEarlier(t, sysResources)
+	resourceName := terraformResourceTypeIpRoute + ".testacc"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIpRouteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "mikrotik_ip_route" "testacc" {
+						dst_address = "10.78.1.0/24"
+						blackhole   = true
+						comment     = "testacc blackhole"
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "blackhole", "true"),
+					resource.TestCheckResourceAttr(resourceName, "routing_table", "main"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIpRouteDestroy(s *terraform.State) error {
+	c := client.NewClient(client.GetConfigFromEnv())
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != terraformResourceTypeIpRoute {
+			continue
+		}
+
+		remoteRecord, err := c.FindIpRoute(rs.Primary.ID)
+		if err != nil && !client.IsNotFoundError(err) {
+			return fmt.Errorf("expected not found error, got %+#v", err)
+		}
+
+		if remoteRecord != nil {
+			return fmt.Errorf("resource %T with id %q still exists in remote system", remoteRecord, remoteRecord.Id)
+		}
+	}
+	return nil
+}
+
+func testAccIpRouteConfig(gateway string, distance int) string {
+	return fmt.Sprintf(`
+		resource "mikrotik_ip_route" "testacc" {
+			dst_address = "10.78.0.0/24"
+			gateway     = %q
+			distance    = %d
+			comment     = "testacc route"
+			disabled    = true
+		}
+	`, gateway, distance)
+}
@@ -0,0 +1,184 @@
+package mikrotik
+
+import (
+	"context"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/ddelnano/terraform-provider-mikrotik/mikrotik/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ipService struct {
+	client *client.Mikrotik
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &ipService{}
+	_ resource.ResourceWithConfigure   = &ipService{}
+	_ resource.ResourceWithImportState = &ipService{}
+)
+
+// NewIpServiceResource is a helper function to simplify the provider implementation.
+func NewIpServiceResource() resource.Resource {
+	return &ipService{}
+}
+
+func (r *ipService) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*client.Mikrotik)
+}
+
+// Metadata returns the resource type name.
+func (r *ipService) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ip_service"
+}
+
+// Schema defines the schema for the resource.
+func (s *ipService) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages settings of a built-in MikroTik management service, e.g. `ssh` or `winbox`. " +
+			"Services cannot be created or removed, so the resource takes over the existing service and destroying it restores factory settings of the service.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Unique ID of this resource.",
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("api", "api-ssl", "ftp", "ssh", "telnet", "winbox", "www", "www-ssl"),
+				},
+				Description: "Name of the service: `api`, `api-ssl`, `ftp`, `ssh`, `telnet`, `winbox`, `www` or `www-ssl`.",
+			},
+			"address": schema.SetAttribute{
+				Optional:    true,
+				Computed:    true,
+				ElementType: tftypes.StringType,
+				Default:     setdefault.StaticValue(tftypes.SetValueMust(tftypes.StringType, []attr.Value{})),
+				Description: "Networks the service accepts connections from. If not set, connections from any address are accepted.",
+			},
+			"certificate": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Name of the certificate used by the service. Supported by `api-ssl` and `www-ssl` services only.",
+			},
+			"disabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the service is disabled.",
+			},
+			"port": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Port the service listens on.",
+			},
+			"tls_version": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Allowed TLS versions: `any` or `only-1.2`. Supported by `api-ssl` and `www-ssl` services only.",
+			},
+		},
+	}
+}
+
+// Create takes over the existing service and sets the initial Terraform state.
+func (r *ipService) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var terraformModel ipServiceModel
+	var mikrotikModel client.IpService
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &terraformModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	existing, err := r.client.FindIpService(terraformModel.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Could not find the service", err.Error())
+		return
+	}
+
+	if err := utils.TerraformModelToMikrotikStruct(ctx, &terraformModel, &mikrotikModel); err != nil {
+		resp.Diagnostics.AddError("Cannot copy model: Terraform -> MikroTik", err.Error())
+		return
+	}
+	mikrotikModel.Id = existing.Id
+
+	updated, err := r.client.UpdateIpService(&mikrotikModel)
+	if err != nil {
+		resp.Diagnostics.AddError("Update failed", err.Error())
+		return
+	}
+
+	if err := utils.MikrotikStructToTerraformModel(ctx, updated, &terraformModel); err != nil {
+		resp.Diagnostics.AddError("Cannot copy model: MikroTik -> Terraform", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &terraformModel)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ipService) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var terraformModel ipServiceModel
+	var mikrotikModel client.IpService
+	GenericReadResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *ipService) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var terraformModel ipServiceModel
+	var mikrotikModel client.IpService
+	GenericUpdateResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+// Delete restores factory settings of the service and removes the Terraform state on success.
+func (r *ipService) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var terraformModel ipServiceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &terraformModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.ResetIpService(terraformModel.Name.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Could not restore default settings of the service", err.Error())
+		return
+	}
+}
+
+func (r *ipService) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
+
+type ipServiceModel struct {
+	Id          tftypes.String `tfsdk:"id"`
+	Name        tftypes.String `tfsdk:"name"`
+	Address     tftypes.Set    `tfsdk:"address"`
+	Certificate tftypes.String `tfsdk:"certificate"`
+	Disabled    tftypes.Bool   `tfsdk:"disabled"`
+	Port        tftypes.Int64  `tfsdk:"port"`
+	TlsVersion  tftypes.String `tfsdk:"tls_version"`
+}
@@ -0,0 +1,105 @@
+package mikrotik
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestIpService_basic(t *testing.T) {
+	resourceName := "mikrotik_ip_service.telnet"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIpServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIpServiceConfig(true, 2323),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", "telnet"),
+					resource.TestCheckResourceAttr(resourceName, "disabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "port", "2323"),
+					resource.TestCheckResourceAttr(resourceName, "address.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "address.*", "192.168.88.0/24"),
+				),
+			},
+			{
+				Config: testAccIpServiceConfig(false, 2424),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "disabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "port", "2424"),
+				),
+			},
+			{
+				Config: `
+					resource "mikrotik_ip_service" "telnet" {
+						name     = "telnet"
+						disabled = false
+						port     = 2424
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "address.#", "0"),
+					testAccCheckIpServiceAddress("telnet", 0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     "telnet",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIpServiceDestroy(s *terraform.State) error {
+	c := client.NewClient(client.GetConfigFromEnv())
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "mikrotik_ip_service" {
+			continue
+		}
+
+		service, err := c.FindIpService(rs.Primary.Attributes["name"])
+		if err != nil {
+			return err
+		}
+
+		if service.Disabled || service.Port != 23 || len(service.Address) > 0 {
+			return fmt.Errorf("service %q was not restored to defaults: %+v", service.Name, service)
+		}
+	}
+	return nil
+}
+
+// testAccCheckIpServiceAddress ensures the service on the router accepts connections from given number of networks
+func testAccCheckIpServiceAddress(name string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		c := client.NewClient(client.GetConfigFromEnv())
+		service, err := c.FindIpService(name)
+		if err != nil {
+			return err
+		}
+		if len(service.Address) != count {
+			return fmt.Errorf("expected %d addresses of service %q, got %q", count, name, service.Address)
+		}
+
+		return nil
+	}
+}
+
+func testAccIpServiceConfig(disabled bool, port int) string {
+	return fmt.Sprintf(`
+		resource "mikrotik_ip_service" "telnet" {
+			name     = "telnet"
+			disabled = %t
+			port     = %d
+			address  = ["192.168.88.0/24"]
+		}
+	`, disabled, port)
+}
@@ -0,0 +1,129 @@
+package mikrotik
+
+import (
+	"context"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/ddelnano/terraform-provider-mikrotik/mikrotik/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ipv6FirewallAddressList struct {
+	client *client.Mikrotik
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &ipv6FirewallAddressList{}
+	_ resource.ResourceWithConfigure   = &ipv6FirewallAddressList{}
+	_ resource.ResourceWithImportState = &ipv6FirewallAddressList{}
+)
+
+// NewIpv6FirewallAddressListResource is a helper function to simplify the provider implementation.
+func NewIpv6FirewallAddressListResource() resource.Resource {
+	return &ipv6FirewallAddressList{}
+}
+
+func (r *ipv6FirewallAddressList) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*client.Mikrotik)
+}
+
+// Metadata returns the resource type name.
+func (r *ipv6FirewallAddressList) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ipv6_firewall_address_list"
+}
+
+// Schema defines the schema for the resource.
+func (s *ipv6FirewallAddressList) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates an entry in MikroTik IPv6 firewall address list.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Unique ID of this resource.",
+			},
+			"address": schema.StringAttribute{
+				Required:    true,
+				Description: "IPv6 address, prefix or DNS name to add to the list.",
+			},
+			"comment": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Comment to the entry.",
+			},
+			"disabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the entry is disabled.",
+			},
+			"list": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the address list.",
+			},
+			"timeout": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Time in seconds after which the entry is removed from the list. The entry is created again on the next apply after it expires.",
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *ipv6FirewallAddressList) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var terraformModel ipv6FirewallAddressListModel
+	var mikrotikModel client.Ipv6FirewallAddressList
+	GenericCreateResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+	keepConfiguredTimeout(ctx, req.Plan.GetAttribute, &resp.State, &resp.Diagnostics)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ipv6FirewallAddressList) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var terraformModel ipv6FirewallAddressListModel
+	var mikrotikModel client.Ipv6FirewallAddressList
+	GenericReadResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+	keepConfiguredTimeout(ctx, req.State.GetAttribute, &resp.State, &resp.Diagnostics)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *ipv6FirewallAddressList) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var terraformModel ipv6FirewallAddressListModel
+	var mikrotikModel client.Ipv6FirewallAddressList
+	GenericUpdateResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+	keepConfiguredTimeout(ctx, req.Plan.GetAttribute, &resp.State, &resp.Diagnostics)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *ipv6FirewallAddressList) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var terraformModel ipv6FirewallAddressListModel
+	var mikrotikModel client.Ipv6FirewallAddressList
+	GenericDeleteResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+func (r *ipv6FirewallAddressList) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	utils.ImportUppercaseWrapper(resource.ImportStatePassthroughID)(ctx, path.Root("id"), req, resp)
+}
+
+type ipv6FirewallAddressListModel struct {
+	Id       tftypes.String `tfsdk:"id"`
+	Address  tftypes.String `tfsdk:"address"`
+	Comment  tftypes.String `tfsdk:"comment"`
+	Disabled tftypes.Bool   `tfsdk:"disabled"`
+	List     tftypes.String `tfsdk:"list"`
+	Timeout  tftypes.Int64  `tfsdk:"timeout"`
+}
@@ -0,0 +1,98 @@
+package mikrotik
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var terraformResourceTypeIpv6FirewallAddressList string = "mikrotik_ipv6_firewall_address_list"
+
+func TestIpv6FirewallAddressList_basic(t *testing.T) {
+	resourceName := terraformResourceTypeIpv6FirewallAddressList + ".testacc"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIpv6FirewallAddressListDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIpv6FirewallAddressListConfig("2001:db8:30::1", "first"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "list", "testacc-list"),
+					resource.TestCheckResourceAttr(resourceName, "address", "2001:db8:30::1"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "first"),
+				),
+			},
+			{
+				Config: testAccIpv6FirewallAddressListConfig("2001:db8:30::/48", "second"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "address", "2001:db8:30::/48"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "second"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestIpv6FirewallAddressList_timeout(t *testing.T) {
+	resourceName := terraformResourceTypeIpv6FirewallAddressList + ".testacc"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIpv6FirewallAddressListDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "mikrotik_ipv6_firewall_address_list" "testacc" {
+						list    = "testacc-list"
+						address = "2001:db8:30::2"
+						timeout = 3600
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "timeout", "3600"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIpv6FirewallAddressListDestroy(s *terraform.State) error {
+	c := client.NewClient(client.GetConfigFromEnv())
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != terraformResourceTypeIpv6FirewallAddressList {
+			continue
+		}
+
+		remoteRecord, err := c.FindIpv6FirewallAddressList(rs.Primary.ID)
+		if err != nil && !client.IsNotFoundError(err) {
+			return fmt.Errorf("expected not found error, got %+#v", err)
+		}
+
+		if remoteRecord != nil {
+			return fmt.Errorf("resource %T with id %q still exists in remote system", remoteRecord, remoteRecord.Id)
+		}
+	}
+	return nil
+}
+
+func testAccIpv6FirewallAddressListConfig(address, comment string) string {
+	return fmt.Sprintf(`
+		resource "mikrotik_ipv6_firewall_address_list" "testacc" {
+			list    = "testacc-list"
+			address = %q
+			comment = %q
+		}
+	`, address, comment)
+}
@@ -0,0 +1,167 @@
+package mikrotik
+
+import (
+	"context"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/ddelnano/terraform-provider-mikrotik/mikrotik/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ipv6FirewallFilterRule struct {
+	client *client.Mikrotik
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &ipv6FirewallFilterRule{}
+	_ resource.ResourceWithConfigure   = &ipv6FirewallFilterRule{}
+	_ resource.ResourceWithImportState = &ipv6FirewallFilterRule{}
+)
+
+// NewIpv6FirewallFilterRuleResource is a helper function to simplify the provider implementation.
+func NewIpv6FirewallFilterRuleResource() resource.Resource {
+	return &ipv6FirewallFilterRule{}
+}
+
+func (r *ipv6FirewallFilterRule) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*client.Mikrotik)
+}
+
+// Metadata returns the resource type name.
+func (r *ipv6FirewallFilterRule) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ipv6_firewall_filter_rule"
+}
+
+// Schema defines the schema for the resource.
+func (s *ipv6FirewallFilterRule) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates a MikroTik IPv6 firewall filter rule.",
+		Attributes: mergeAttributes(
+			firewallMatcherAttributes(),
+			firewallConnectionMatcherAttributes(),
+			map[string]schema.Attribute{
+				"id": schema.StringAttribute{
+					Computed: true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+					Description: "Unique ID of this resource.",
+				},
+				"action": schema.StringAttribute{
+					Optional:    true,
+					Computed:    true,
+					Default:     stringdefault.StaticString("accept"),
+					Description: "Action to take if packet is matched by the rule.",
+				},
+				"chain": schema.StringAttribute{
+					Required:    true,
+					Description: "Specifies to which chain rule will be added. If the input does not match the name of an already defined chain, a new chain will be created.",
+				},
+				"place_before": placeBeforeAttribute(),
+				"protocol": schema.StringAttribute{
+					Optional:    true,
+					Computed:    true,
+					Description: "Matches particular IP protocol specified by protocol name or number.",
+				},
+				"reject_with": schema.StringAttribute{
+					Optional:    true,
+					Computed:    true,
+					Description: "Specifies ICMP error to be sent back if packet is rejected. Applicable only if `action` is `reject`.",
+				},
+			},
+		),
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *ipv6FirewallFilterRule) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var terraformModel ipv6FirewallFilterRuleModel
+	var mikrotikModel client.Ipv6FirewallFilterRule
+	GenericCreateResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	applyPlaceBefore(r.client, &client.Ipv6FirewallFilterRule{Id: terraformModel.Id.ValueString()}, terraformModel.PlaceBefore, &resp.Diagnostics)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ipv6FirewallFilterRule) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var terraformModel ipv6FirewallFilterRuleModel
+	var mikrotikModel client.Ipv6FirewallFilterRule
+	GenericReadResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	refreshPlaceBefore(ctx, r.client, &client.Ipv6FirewallFilterRule{Id: terraformModel.Id.ValueString()}, terraformModel.PlaceBefore, &resp.State, &resp.Diagnostics)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *ipv6FirewallFilterRule) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var terraformModel ipv6FirewallFilterRuleModel
+	var mikrotikModel client.Ipv6FirewallFilterRule
+	GenericUpdateResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	applyPlaceBefore(r.client, &client.Ipv6FirewallFilterRule{Id: terraformModel.Id.ValueString()}, terraformModel.PlaceBefore, &resp.Diagnostics)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *ipv6FirewallFilterRule) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var terraformModel ipv6FirewallFilterRuleModel
+	var mikrotikModel client.Ipv6FirewallFilterRule
+	GenericDeleteResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+func (r *ipv6FirewallFilterRule) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	utils.ImportUppercaseWrapper(resource.ImportStatePassthroughID)(ctx, path.Root("id"), req, resp)
+}
+
+type ipv6FirewallFilterRuleModel struct {
+	Id                       tftypes.String `tfsdk:"id"`
+	Action                   tftypes.String `tfsdk:"action"`
+	Chain                    tftypes.String `tfsdk:"chain"`
+	Comment                  tftypes.String `tfsdk:"comment"`
+	ConnectionMark           tftypes.String `tfsdk:"connection_mark"`
+	ConnectionNatState       tftypes.Set    `tfsdk:"connection_nat_state"`
+	ConnectionNatStateNegate tftypes.Bool   `tfsdk:"connection_nat_state_negate"`
+	ConnectionState          tftypes.Set    `tfsdk:"connection_state"`
+	ConnectionStateNegate    tftypes.Bool   `tfsdk:"connection_state_negate"`
+	DestAddress              tftypes.String `tfsdk:"dst_address"`
+	DestAddressList          tftypes.String `tfsdk:"dst_address_list"`
+	DestPort                 tftypes.String `tfsdk:"dst_port"`
+	Disabled                 tftypes.Bool   `tfsdk:"disabled"`
+	IcmpOptions              tftypes.String `tfsdk:"icmp_options"`
+	InInterface              tftypes.String `tfsdk:"in_interface"`
+	InInterfaceList          tftypes.String `tfsdk:"in_interface_list"`
+	JumpTarget               tftypes.String `tfsdk:"jump_target"`
+	Limit                    tftypes.String `tfsdk:"limit"`
+	Log                      tftypes.Bool   `tfsdk:"log"`
+	LogPrefix                tftypes.String `tfsdk:"log_prefix"`
+	OutInterface             tftypes.String `tfsdk:"out_interface"`
+	OutInterfaceList         tftypes.String `tfsdk:"out_interface_list"`
+	PacketMark               tftypes.String `tfsdk:"packet_mark"`
+	PlaceBefore              tftypes.String `tfsdk:"place_before"`
+	Protocol                 tftypes.String `tfsdk:"protocol"`
+	RejectWith               tftypes.String `tfsdk:"reject_with"`
+	SrcAddress               tftypes.String `tfsdk:"src_address"`
+	SrcAddressList           tftypes.String `tfsdk:"src_address_list"`
+	SrcPort                  tftypes.String `tfsdk:"src_port"`
+	TcpFlags                 tftypes.Set    `tfsdk:"tcp_flags"`
+}
@@ -0,0 +1,79 @@
+package mikrotik
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var terraformResourceTypeIpv6FirewallFilterRule string = "mikrotik_ipv6_firewall_filter_rule"
+
+func TestIpv6FirewallFilterRule_basic(t *testing.T) {
+	resourceName := terraformResourceTypeIpv6FirewallFilterRule + ".testacc"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIpv6FirewallFilterRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIpv6FirewallFilterRuleConfig("accept", "2001:db8::/32"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "action", "accept"),
+					resource.TestCheckResourceAttr(resourceName, "chain", "input"),
+					resource.TestCheckResourceAttr(resourceName, "protocol", "icmpv6"),
+					resource.TestCheckResourceAttr(resourceName, "src_address", "2001:db8::/32"),
+				),
+			},
+			{
+				Config: testAccIpv6FirewallFilterRuleConfig("drop", "2001:db8:1::/48"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "action", "drop"),
+					resource.TestCheckResourceAttr(resourceName, "src_address", "2001:db8:1::/48"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"place_before"},
+			},
+		},
+	})
+}
+
+func testAccCheckIpv6FirewallFilterRuleDestroy(s *terraform.State) error {
+	c := client.NewClient(client.GetConfigFromEnv())
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != terraformResourceTypeIpv6FirewallFilterRule {
+			continue
+		}
+
+		remoteRecord, err := c.FindIpv6FirewallFilterRule(rs.Primary.ID)
+		if err != nil && !client.IsNotFoundError(err) {
+			return fmt.Errorf("expected not found error, got %+#v", err)
+		}
+
+		if remoteRecord != nil {
+			return fmt.Errorf("resource %T with id %q still exists in remote system", remoteRecord, remoteRecord.Id)
+		}
+	}
+	return nil
+}
+
+func testAccIpv6FirewallFilterRuleConfig(action, srcAddress string) string {
+	return fmt.Sprintf(`
+		resource "mikrotik_ipv6_firewall_filter_rule" "testacc" {
+			action      = %q
+			chain       = "input"
+			comment     = "testacc icmpv6"
+			protocol    = "icmpv6"
+			src_address = %q
+			disabled    = true
+		}
+	`, action, srcAddress)
+}
@@ -0,0 +1,203 @@
+package mikrotik
+
+import (
+	"context"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/ddelnano/terraform-provider-mikrotik/mikrotik/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ipv6FirewallMangleRule struct {
+	client *client.Mikrotik
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &ipv6FirewallMangleRule{}
+	_ resource.ResourceWithConfigure   = &ipv6FirewallMangleRule{}
+	_ resource.ResourceWithImportState = &ipv6FirewallMangleRule{}
+	_ resource.ResourceWithModifyPlan  = &ipv6FirewallMangleRule{}
+)
+
+// NewIpv6FirewallMangleRuleResource is a helper function to simplify the provider implementation.
+func NewIpv6FirewallMangleRuleResource() resource.Resource {
+	return &ipv6FirewallMangleRule{}
+}
+
+func (r *ipv6FirewallMangleRule) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*client.Mikrotik)
+}
+
+// Metadata returns the resource type name.
+func (r *ipv6FirewallMangleRule) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ipv6_firewall_mangle_rule"
+}
+
+// Schema defines the schema for the resource.
+func (s *ipv6FirewallMangleRule) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates a MikroTik IPv6 firewall mangle rule.",
+		Attributes: mergeAttributes(
+			firewallMatcherAttributes(),
+			firewallConnectionMatcherAttributes(),
+			map[string]schema.Attribute{
+				"id": schema.StringAttribute{
+					Computed: true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+					Description: "Unique ID of this resource.",
+				},
+				"action": schema.StringAttribute{
+					Optional:    true,
+					Computed:    true,
+					Default:     stringdefault.StaticString("accept"),
+					Description: "Action to take if packet is matched by the rule. Common values are `mark-connection`, `mark-packet`, `mark-routing`, `change-mss`, `accept` and `jump`.",
+				},
+				"chain": schema.StringAttribute{
+					Required:    true,
+					Description: "Specifies to which chain rule will be added, e.g. `prerouting`, `input`, `forward`, `output` or `postrouting`. If the input does not match the name of an already defined chain, a new chain will be created.",
+				},
+				"new_connection_mark": schema.StringAttribute{
+					Optional:    true,
+					Computed:    true,
+					Description: "Connection mark to set. Applicable only if `action` is `mark-connection`.",
+				},
+				"new_mss": schema.StringAttribute{
+					Optional:    true,
+					Computed:    true,
+					Description: "New MSS value or `clamp-to-pmtu`. Applicable only if `action` is `change-mss`.",
+				},
+				"new_packet_mark": schema.StringAttribute{
+					Optional:    true,
+					Computed:    true,
+					Description: "Packet mark to set. Applicable only if `action` is `mark-packet`.",
+				},
+				"new_routing_mark": schema.StringAttribute{
+					Optional:    true,
+					Computed:    true,
+					Description: "Routing mark to set. Applicable only if `action` is `mark-routing`.",
+				},
+				"passthrough": schema.BoolAttribute{
+					Optional:    true,
+					Computed:    true,
+					Description: "Whether to let the packet continue to the next rule after a marking or changing action. Applicable only to `mark-*` and `change-*` actions, defaults to `true` for them.",
+				},
+				"place_before": placeBeforeAttribute(),
+				"protocol": schema.StringAttribute{
+					Optional:    true,
+					Computed:    true,
+					Description: "Matches particular IP protocol specified by protocol name or number.",
+				},
+				"routing_mark": schema.StringAttribute{
+					Optional:    true,
+					Computed:    true,
+					Description: "Matches packets marked by mangle facility with particular routing mark. Prefix the value with `!` to negate the matcher.",
+				},
+			},
+		),
+	}
+}
+
+// ModifyPlan computes `passthrough` from the action, when it is not set explicitly.
+func (r *ipv6FirewallMangleRule) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyManglePassthroughPlan(ctx, req, resp)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *ipv6FirewallMangleRule) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var terraformModel ipv6FirewallMangleRuleModel
+	var mikrotikModel client.Ipv6FirewallMangleRule
+	GenericCreateResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	applyPlaceBefore(r.client, &client.Ipv6FirewallMangleRule{Id: terraformModel.Id.ValueString()}, terraformModel.PlaceBefore, &resp.Diagnostics)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ipv6FirewallMangleRule) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var terraformModel ipv6FirewallMangleRuleModel
+	var mikrotikModel client.Ipv6FirewallMangleRule
+	GenericReadResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	refreshPlaceBefore(ctx, r.client, &client.Ipv6FirewallMangleRule{Id: terraformModel.Id.ValueString()}, terraformModel.PlaceBefore, &resp.State, &resp.Diagnostics)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *ipv6FirewallMangleRule) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var terraformModel ipv6FirewallMangleRuleModel
+	var mikrotikModel client.Ipv6FirewallMangleRule
+	GenericUpdateResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	applyPlaceBefore(r.client, &client.Ipv6FirewallMangleRule{Id: terraformModel.Id.ValueString()}, terraformModel.PlaceBefore, &resp.Diagnostics)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *ipv6FirewallMangleRule) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var terraformModel ipv6FirewallMangleRuleModel
+	var mikrotikModel client.Ipv6FirewallMangleRule
+	GenericDeleteResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+func (r *ipv6FirewallMangleRule) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	utils.ImportUppercaseWrapper(resource.ImportStatePassthroughID)(ctx, path.Root("id"), req, resp)
+}
+
+type ipv6FirewallMangleRuleModel struct {
+	Id                       tftypes.String `tfsdk:"id"`
+	Action                   tftypes.String `tfsdk:"action"`
+	Chain                    tftypes.String `tfsdk:"chain"`
+	Comment                  tftypes.String `tfsdk:"comment"`
+	ConnectionMark           tftypes.String `tfsdk:"connection_mark"`
+	ConnectionNatState       tftypes.Set    `tfsdk:"connection_nat_state"`
+	ConnectionNatStateNegate tftypes.Bool   `tfsdk:"connection_nat_state_negate"`
+	ConnectionState          tftypes.Set    `tfsdk:"connection_state"`
+	ConnectionStateNegate    tftypes.Bool   `tfsdk:"connection_state_negate"`
+	DestAddress              tftypes.String `tfsdk:"dst_address"`
+	DestAddressList          tftypes.String `tfsdk:"dst_address_list"`
+	DestPort                 tftypes.String `tfsdk:"dst_port"`
+	Disabled                 tftypes.Bool   `tfsdk:"disabled"`
+	IcmpOptions              tftypes.String `tfsdk:"icmp_options"`
+	InInterface              tftypes.String `tfsdk:"in_interface"`
+	InInterfaceList          tftypes.String `tfsdk:"in_interface_list"`
+	JumpTarget               tftypes.String `tfsdk:"jump_target"`
+	Limit                    tftypes.String `tfsdk:"limit"`
+	Log                      tftypes.Bool   `tfsdk:"log"`
+	LogPrefix                tftypes.String `tfsdk:"log_prefix"`
+	NewConnectionMark        tftypes.String `tfsdk:"new_connection_mark"`
+	NewMss                   tftypes.String `tfsdk:"new_mss"`
+	NewPacketMark            tftypes.String `tfsdk:"new_packet_mark"`
+	NewRoutingMark           tftypes.String `tfsdk:"new_routing_mark"`
+	OutInterface             tftypes.String `tfsdk:"out_interface"`
+	OutInterfaceList         tftypes.String `tfsdk:"out_interface_list"`
+	PacketMark               tftypes.String `tfsdk:"packet_mark"`
+	Passthrough              tftypes.Bool   `tfsdk:"passthrough"`
+	PlaceBefore              tftypes.String `tfsdk:"place_before"`
+	Protocol                 tftypes.String `tfsdk:"protocol"`
+	RoutingMark              tftypes.String `tfsdk:"routing_mark"`
+	SrcAddress               tftypes.String `tfsdk:"src_address"`
+	SrcAddressList           tftypes.String `tfsdk:"src_address_list"`
+	SrcPort                  tftypes.String `tfsdk:"src_port"`
+	TcpFlags                 tftypes.Set    `tfsdk:"tcp_flags"`
+}
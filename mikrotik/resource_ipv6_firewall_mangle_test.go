@@ -0,0 +1,92 @@
+package mikrotik
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var terraformResourceTypeIpv6FirewallMangleRule string = "mikrotik_ipv6_firewall_mangle_rule"
+
+func TestIpv6FirewallMangleRule_basic(t *testing.T) {
+	resourceName := terraformResourceTypeIpv6FirewallMangleRule + ".testacc"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIpv6FirewallMangleRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIpv6FirewallMangleRuleConfig("testacc-mark"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "action", "mark-connection"),
+					resource.TestCheckResourceAttr(resourceName, "chain", "prerouting"),
+					resource.TestCheckResourceAttr(resourceName, "new_connection_mark", "testacc-mark"),
+					resource.TestCheckResourceAttr(resourceName, "passthrough", "true"),
+				),
+			},
+			{
+				Config: testAccIpv6FirewallMangleRuleConfig("testacc-mark-updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "new_connection_mark", "testacc-mark-updated"),
+				),
+			},
+			{
+				Config: `
+					resource "mikrotik_ipv6_firewall_mangle_rule" "testacc" {
+						action      = "accept"
+						chain       = "prerouting"
+						src_address = "2001:db8::/32"
+						disabled    = true
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "action", "accept"),
+					resource.TestCheckResourceAttr(resourceName, "passthrough", "false"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"place_before"},
+			},
+		},
+	})
+}
+
+func testAccCheckIpv6FirewallMangleRuleDestroy(s *terraform.State) error {
+	c := client.NewClient(client.GetConfigFromEnv())
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != terraformResourceTypeIpv6FirewallMangleRule {
+			continue
+		}
+
+		remoteRecord, err := c.FindIpv6FirewallMangleRule(rs.Primary.ID)
+		if err != nil && !client.IsNotFoundError(err) {
+			return fmt.Errorf("expected not found error, got %+#v", err)
+		}
+
+		if remoteRecord != nil {
+			return fmt.Errorf("resource %T with id %q still exists in remote system", remoteRecord, remoteRecord.Id)
+		}
+	}
+	return nil
+}
+
+func testAccIpv6FirewallMangleRuleConfig(mark string) string {
+	return fmt.Sprintf(`
+		resource "mikrotik_ipv6_firewall_mangle_rule" "testacc" {
+			action              = "mark-connection"
+			chain               = "prerouting"
+			connection_state    = ["new"]
+			new_connection_mark = %q
+			src_address         = "2001:db8::/32"
+			disabled            = true
+		}
+	`, mark)
+}
@@ -0,0 +1,84 @@
+package mikrotik
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var terraformResourceTypeIpv6FirewallNatRule string = "mikrotik_ipv6_firewall_nat_rule"
+
+func TestIpv6FirewallNatRule_portForward(t *testing.T) {
+	client.SkipIfRouterOSV6OrEarlier(t, sysResources)
+	resourceName := terraformResourceTypeIpv6FirewallNatRule + ".testacc"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIpv6FirewallNatRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIpv6FirewallNatRulePortForwardConfig("8080", "80"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "action", "dst-nat"),
+					resource.TestCheckResourceAttr(resourceName, "chain", "dstnat"),
+					resource.TestCheckResourceAttr(resourceName, "dst_port", "8080"),
+					resource.TestCheckResourceAttr(resourceName, "to_address", "fd00::10/128"),
+					resource.TestCheckResourceAttr(resourceName, "to_ports", "80"),
+				),
+			},
+			{
+				Config: testAccIpv6FirewallNatRulePortForwardConfig("8443", "443"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "dst_port", "8443"),
+					resource.TestCheckResourceAttr(resourceName, "to_ports", "443"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"place_before"},
+			},
+		},
+	})
+}
+
+func testAccCheckIpv6FirewallNatRuleDestroy(s *terraform.State) error {
+	c := client.NewClient(client.GetConfigFromEnv())
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != terraformResourceTypeIpv6FirewallNatRule {
+			continue
+		}
+
+		remoteRecord, err := c.FindIpv6FirewallNatRule(rs.Primary.ID)
+		if err != nil && !client.IsNotFoundError(err) {
+			return fmt.Errorf("expected not found error, got %+#v", err)
+		}
+
+		if remoteRecord != nil {
+			return fmt.Errorf("resource %T with id %q still exists in remote system", remoteRecord, remoteRecord.Id)
+		}
+	}
+	return nil
+}
+
+func testAccIpv6FirewallNatRulePortForwardConfig(dstPort, toPorts string) string {
+	return fmt.Sprintf(`
+		resource "mikrotik_ipv6_firewall_nat_rule" "testacc" {
+			action            = "dst-nat"
+			chain             = "dstnat"
+			comment           = "testacc port forward"
+			dst_port          = %q
+			in_interface_list = "all"
+			protocol          = "tcp"
+			to_address        = "fd00::10/128"
+			to_ports          = %q
+			disabled          = true
+		}
+	`, dstPort, toPorts)
+}
@@ -0,0 +1,76 @@
+package mikrotik
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var terraformResourceTypeIpv6Route string = "mikrotik_ipv6_route"
+
+func TestIpv6Route_basic(t *testing.T) {
+	resourceName := terraformResourceTypeIpv6Route + ".testacc"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIpv6RouteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIpv6RouteConfig("fe80::1%ether1", 5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "dst_address", "2001:db8:78::/48"),
+					resource.TestCheckResourceAttr(resourceName, "gateway", "fe80::1%ether1"),
+					resource.TestCheckResourceAttr(resourceName, "distance", "5"),
+				),
+			},
+			{
+				Config: testAccIpv6RouteConfig("fe80::2%ether1", 10),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "gateway", "fe80::2%ether1"),
+					resource.TestCheckResourceAttr(resourceName, "distance", "10"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIpv6RouteDestroy(s *terraform.State) error {
+	c := client.NewClient(client.GetConfigFromEnv())
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != terraformResourceTypeIpv6Route {
+			continue
+		}
+
+		remoteRecord, err := c.FindIpv6Route(rs.Primary.ID)
+		if err != nil && !client.IsNotFoundError(err) {
+			return fmt.Errorf("expected not found error, got %+#v", err)
+		}
+
+		if remoteRecord != nil {
+			return fmt.Errorf("resource %T with id %q still exists in remote system", remoteRecord, remoteRecord.Id)
+		}
+	}
+	return nil
+}
+
+func testAccIpv6RouteConfig(gateway string, distance int) string {
+	return fmt.Sprintf(`
+		resource "mikrotik_ipv6_route" "testacc" {
+			dst_address = "2001:db8:78::/48"
+			gateway     = %q
+			distance    = %d
+			comment     = "testacc route"
+			disabled    = true
+		}
+	`, gateway, distance)
+}
@@ -0,0 +1,57 @@
+package mikrotik
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccMikrotikMacServer_basic(t *testing.T) {
+	resourceName := "mikrotik_mac_server.settings"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMikrotikMacServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "mikrotik_mac_server" "settings" {
+						allowed_interface_list = "none"
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "/tool/mac-server"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_interface_list", "none"),
+				),
+			},
+			{
+				ImportState:       true,
+				ResourceName:      resourceName,
+				ImportStateId:     "/tool/mac-server",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckMikrotikMacServerDestroy(s *terraform.State) error {
+	c := client.NewClient(client.GetConfigFromEnv())
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "mikrotik_mac_server" {
+			continue
+		}
+
+		settings, err := c.FindMacServer()
+		if err != nil {
+			return err
+		}
+
+		if settings.AllowedInterfaceList != "all" {
+			return fmt.Errorf("MAC server settings were not restored to defaults: %+v", settings)
+		}
+	}
+	return nil
+}
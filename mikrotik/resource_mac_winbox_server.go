@@ -0,0 +1,104 @@
+package mikrotik
+
+import (
+	"context"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type macWinboxServer struct {
+	client *client.Mikrotik
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &macWinboxServer{}
+	_ resource.ResourceWithConfigure   = &macWinboxServer{}
+	_ resource.ResourceWithImportState = &macWinboxServer{}
+)
+
+// NewMacWinboxServerResource is a helper function to simplify the provider implementation.
+func NewMacWinboxServerResource() resource.Resource {
+	return &macWinboxServer{}
+}
+
+func (r *macWinboxServer) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*client.Mikrotik)
+}
+
+// Metadata returns the resource type name.
+func (r *macWinboxServer) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mac_winbox_server"
+}
+
+// Schema defines the schema for the resource.
+func (s *macWinboxServer) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages MAC Winbox server settings of the MikroTik device. Destroying the resource restores default settings.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Unique ID of this resource.",
+			},
+			"allowed_interface_list": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("all"),
+				Description: "Interface list the MAC Winbox server accepts connections on, e.g. `LAN`, `all` or `none`.",
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *macWinboxServer) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var terraformModel macWinboxServerModel
+	var mikrotikModel client.MacWinboxServer
+	GenericCreateSingleton(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *macWinboxServer) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var terraformModel macWinboxServerModel
+	var mikrotikModel client.MacWinboxServer
+	GenericReadSingleton(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *macWinboxServer) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var terraformModel macWinboxServerModel
+	var mikrotikModel client.MacWinboxServer
+	GenericUpdateSingleton(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *macWinboxServer) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var terraformModel macWinboxServerModel
+	var mikrotikModel client.MacWinboxServer
+	GenericDeleteSingleton(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+func (r *macWinboxServer) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+type macWinboxServerModel struct {
+	Id                   tftypes.String `tfsdk:"id"`
+	AllowedInterfaceList tftypes.String `tfsdk:"allowed_interface_list"`
+}
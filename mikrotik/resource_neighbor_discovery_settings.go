@@ -0,0 +1,111 @@
+package mikrotik
+
+import (
+	"context"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type neighborDiscoverySettings struct {
+	client *client.Mikrotik
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &neighborDiscoverySettings{}
+	_ resource.ResourceWithConfigure   = &neighborDiscoverySettings{}
+	_ resource.ResourceWithImportState = &neighborDiscoverySettings{}
+)
+
+// NewNeighborDiscoverySettingsResource is a helper function to simplify the provider implementation.
+func NewNeighborDiscoverySettingsResource() resource.Resource {
+	return &neighborDiscoverySettings{}
+}
+
+func (r *neighborDiscoverySettings) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*client.Mikrotik)
+}
+
+// Metadata returns the resource type name.
+func (r *neighborDiscoverySettings) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_neighbor_discovery_settings"
+}
+
+// Schema defines the schema for the resource.
+func (s *neighborDiscoverySettings) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages neighbor discovery settings of the MikroTik device. Destroying the resource restores default settings.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Unique ID of this resource.",
+			},
+			"discover_interface_list": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("static"),
+				Description: "Interface list neighbors are discovered and announced on, e.g. `LAN`, `all` or `none`.",
+			},
+			"protocol": schema.SetAttribute{
+				Optional:    true,
+				Computed:    true,
+				ElementType: tftypes.StringType,
+				Description: "Discovery protocols in use: `cdp`, `lldp` and `mndp`. Supported on RouterOS v7 only.",
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *neighborDiscoverySettings) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var terraformModel neighborDiscoverySettingsModel
+	var mikrotikModel client.NeighborDiscoverySettings
+	GenericCreateSingleton(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *neighborDiscoverySettings) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var terraformModel neighborDiscoverySettingsModel
+	var mikrotikModel client.NeighborDiscoverySettings
+	GenericReadSingleton(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *neighborDiscoverySettings) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var terraformModel neighborDiscoverySettingsModel
+	var mikrotikModel client.NeighborDiscoverySettings
+	GenericUpdateSingleton(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *neighborDiscoverySettings) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var terraformModel neighborDiscoverySettingsModel
+	var mikrotikModel client.NeighborDiscoverySettings
+	GenericDeleteSingleton(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+func (r *neighborDiscoverySettings) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+type neighborDiscoverySettingsModel struct {
+	Id                    tftypes.String `tfsdk:"id"`
+	DiscoverInterfaceList tftypes.String `tfsdk:"discover_interface_list"`
+	Protocol              tftypes.Set    `tfsdk:"protocol"`
+}
@@ -0,0 +1,57 @@
+package mikrotik
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccMikrotikNeighborDiscoverySettings_basic(t *testing.T) {
+	resourceName := "mikrotik_neighbor_discovery_settings.settings"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMikrotikNeighborDiscoverySettingsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "mikrotik_neighbor_discovery_settings" "settings" {
+						discover_interface_list = "none"
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "/ip/neighbor/discovery-settings"),
+					resource.TestCheckResourceAttr(resourceName, "discover_interface_list", "none"),
+				),
+			},
+			{
+				ImportState:       true,
+				ResourceName:      resourceName,
+				ImportStateId:     "/ip/neighbor/discovery-settings",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckMikrotikNeighborDiscoverySettingsDestroy(s *terraform.State) error {
+	c := client.NewClient(client.GetConfigFromEnv())
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "mikrotik_neighbor_discovery_settings" {
+			continue
+		}
+
+		settings, err := c.FindNeighborDiscoverySettings()
+		if err != nil {
+			return err
+		}
+
+		if settings.DiscoverInterfaceList != "static" {
+			return fmt.Errorf("neighbor discovery settings were not restored to defaults: %+v", settings)
+		}
+	}
+	return nil
+}
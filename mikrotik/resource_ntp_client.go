@@ -0,0 +1,131 @@
+package mikrotik
+
+import (
+	"context"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ntpClient struct {
+	client *client.Mikrotik
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &ntpClient{}
+	_ resource.ResourceWithConfigure   = &ntpClient{}
+	_ resource.ResourceWithImportState = &ntpClient{}
+)
+
+// NewNtpClientResource is a helper function to simplify the provider implementation.
+func NewNtpClientResource() resource.Resource {
+	return &ntpClient{}
+}
+
+func (r *ntpClient) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*client.Mikrotik)
+}
+
+// Metadata returns the resource type name.
+func (r *ntpClient) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ntp_client"
+}
+
+// Schema defines the schema for the resource.
+func (s *ntpClient) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages NTP client settings of the MikroTik device. Destroying the resource disables the NTP client.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Unique ID of this resource.",
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether the NTP client is enabled.",
+			},
+			"mode": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("unicast"),
+				Description: "Mode of the NTP client: `unicast`, `broadcast`, `multicast` or `manycast`.",
+			},
+			"servers": schema.ListAttribute{
+				Optional:    true,
+				Computed:    true,
+				ElementType: tftypes.StringType,
+				Description: "List of NTP servers. Supported on RouterOS v7 only.",
+			},
+			"primary_ntp": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Address of the primary NTP server. Supported on RouterOS v6 only.",
+			},
+			"secondary_ntp": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Address of the secondary NTP server. Supported on RouterOS v6 only.",
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *ntpClient) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var terraformModel ntpClientModel
+	var mikrotikModel client.NtpClient
+	GenericCreateSingleton(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ntpClient) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var terraformModel ntpClientModel
+	var mikrotikModel client.NtpClient
+	GenericReadSingleton(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *ntpClient) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var terraformModel ntpClientModel
+	var mikrotikModel client.NtpClient
+	GenericUpdateSingleton(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *ntpClient) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var terraformModel ntpClientModel
+	var mikrotikModel client.NtpClient
+	GenericDeleteSingleton(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+func (r *ntpClient) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+type ntpClientModel struct {
+	Id           tftypes.String `tfsdk:"id"`
+	Enabled      tftypes.Bool   `tfsdk:"enabled"`
+	Mode         tftypes.String `tfsdk:"mode"`
+	Servers      tftypes.List   `tfsdk:"servers"`
+	PrimaryNtp   tftypes.String `tfsdk:"primary_ntp"`
+	SecondaryNtp tftypes.String `tfsdk:"secondary_ntp"`
+}
@@ -0,0 +1,95 @@
+package mikrotik
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccMikrotikNtpClient_v6(t *testing.T) {
+	client.SkipIfRouterOSV7OrLater(t, sysResources)
+
+	resourceName := "mikrotik_ntp_client.ntp"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMikrotikNtpClientDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "mikrotik_ntp_client" "ntp" {
+						primary_ntp   = "192.168.88.10"
+						secondary_ntp = "192.168.88.11"
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "/system/ntp/client"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "primary_ntp", "192.168.88.10"),
+					resource.TestCheckResourceAttr(resourceName, "secondary_ntp", "192.168.88.11"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMikrotikNtpClient_v7(t *testing.T) {
+	client.SkipIfRouterOSV6OrEarlier(t, sysResources)
+
+	resourceName := "mikrotik_ntp_client.ntp"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMikrotikNtpClientDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "mikrotik_ntp_client" "ntp" {
+						servers = ["192.168.88.10", "192.168.88.11"]
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "/system/ntp/client"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "mode", "unicast"),
+					resource.TestCheckResourceAttr(resourceName, "servers.#", "2"),
+				),
+			},
+			{
+				Config: `
+					resource "mikrotik_ntp_client" "ntp" {
+						enabled = false
+						servers = ["192.168.88.12"]
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "servers.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "servers.0", "192.168.88.12"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckMikrotikNtpClientDestroy(s *terraform.State) error {
+	c := client.NewClient(client.GetConfigFromEnv())
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "mikrotik_ntp_client" {
+			continue
+		}
+
+		ntp, err := c.FindNtpClient()
+		if err != nil {
+			return err
+		}
+
+		if ntp.Enabled {
+			return fmt.Errorf("ntp client is still enabled")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,245 @@
+package mikrotik
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/ddelnano/terraform-provider-mikrotik/mikrotik/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// placeBeforeAttribute returns schema of `place_before` attribute for resources which are items of ordered list.
+func placeBeforeAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:    true,
+		Description: "ID of the rule before which this rule is placed. If the rule is found after the referenced one during refresh, it is moved back on the next apply. If the referenced rule does not exist, the position is not enforced. Without this attribute, new rules are appended to the end of the table.",
+	}
+}
+
+// applyPlaceBefore moves the resource before the destination one, if the destination is set and the resource is misplaced.
+// A missing destination does not constrain the position, it is only reported with a warning.
+func applyPlaceBefore(c *client.Mikrotik, r client.Resource, destinationID tftypes.String, diags *diag.Diagnostics) {
+	if destinationID.ValueString() == "" {
+		return
+	}
+
+	err := placeBefore(c, r, destinationID.ValueString())
+	if client.IsNotFoundError(err) {
+		addMissingPlaceBeforeWarning(destinationID.ValueString(), diags)
+		return
+	}
+	if err != nil {
+		diags.AddError("Cannot move resource", err.Error())
+	}
+}
+
+// refreshPlaceBefore clears `place_before` attribute in the state if the resource is no longer located before the destination one,
+// so the drift is reported and the resource is placed back on the next apply.
+func refreshPlaceBefore(ctx context.Context, c *client.Mikrotik, r client.Resource, destinationID tftypes.String, state *tfsdk.State, diags *diag.Diagnostics) {
+	if state.Raw.IsNull() || destinationID.ValueString() == "" {
+		return
+	}
+
+	placed, err := isPlacedBefore(c, r, destinationID.ValueString())
+	if client.IsNotFoundError(err) {
+		// keep the attribute, otherwise the diff could never be resolved
+		addMissingPlaceBeforeWarning(destinationID.ValueString(), diags)
+		return
+	}
+	if err != nil {
+		diags.AddError("Cannot check resource position", err.Error())
+		return
+	}
+	if !placed {
+		diags.Append(state.SetAttribute(ctx, path.Root("place_before"), tftypes.StringNull())...)
+	}
+}
+
+func addMissingPlaceBeforeWarning(destinationID string, diags *diag.Diagnostics) {
+	diags.AddAttributeWarning(
+		path.Root("place_before"),
+		"Destination rule is not found",
+		fmt.Sprintf("The rule %q referenced by place_before does not exist, so the position of this rule is not enforced.", destinationID),
+	)
+}
+
+// isPlacedBefore reports whether the resource is located before the destination resource
+// in the ordered list it belongs to.
+// If the destination resource cannot be found, NotFound error is returned.
+// A missing resource itself is reported with a regular error naming its ID.
+func isPlacedBefore(c *client.Mikrotik, r client.Resource, destinationID string) (bool, error) {
+	ids, err := c.ListIDs(r, r.ID(), destinationID)
+	if err != nil {
+		return false, err
+	}
+
+	foundSelf, foundDestination := false, false
+	for _, id := range ids {
+		foundSelf = foundSelf || id == r.ID()
+		foundDestination = foundDestination || id == destinationID
+	}
+	if !foundSelf {
+		return false, fmt.Errorf("rule %q is not found", r.ID())
+	}
+	if !foundDestination {
+		return false, client.NewNotFound(fmt.Sprintf("destination %q is not found", destinationID))
+	}
+
+	return ids[0] == r.ID(), nil
+}
+
+// placeBefore moves the resource right before the destination resource unless it is already located before it.
+func placeBefore(c *client.Mikrotik, r client.Resource, destinationID string) error {
+	placed, err := isPlacedBefore(c, r, destinationID)
+	if err != nil || placed {
+		return err
+	}
+
+	return c.Move(r, destinationID)
+}
+
+// listOwnedItems returns items of the ordered list, which are accepted by owned function, in their current order.
+func listOwnedItems(c *client.Mikrotik, template client.Resource, owned func(client.Resource) bool) ([]client.Resource, error) {
+	items, err := c.List(template)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []client.Resource{}
+	for _, item := range items {
+		if owned(item) {
+			result = append(result, item)
+		}
+	}
+
+	return result, nil
+}
+
+// reconcileOrderedList makes items of the ordered list, which are accepted by owned function, match the desired ones.
+//
+// Items which already have the desired content are kept untouched, the remaining ones are updated in place
+// when it does not require unsetting any property, otherwise they are removed and the missing ones are added.
+// Finally, the items are moved into the desired order with as few moves as possible.
+func reconcileOrderedList(c *client.Mikrotik, template client.Resource, owned func(client.Resource) bool, desired []client.Resource) error {
+	current, err := listOwnedItems(c, template, owned)
+	if err != nil {
+		return err
+	}
+
+	ids := make([]string, len(desired))
+	used := make([]bool, len(current))
+	for i, d := range desired {
+		for j, cur := range current {
+			if !used[j] && sameProperties(cur, d) {
+				ids[i], used[j] = cur.ID(), true
+				break
+			}
+		}
+	}
+	for i, d := range desired {
+		if ids[i] != "" {
+			continue
+		}
+		for j, cur := range current {
+			if used[j] || !canUpdateInPlace(cur, d) {
+				continue
+			}
+			d.SetID(cur.ID())
+			if _, err := c.Update(d); err != nil {
+				return err
+			}
+			ids[i], used[j] = cur.ID(), true
+			break
+		}
+	}
+	for j, cur := range current {
+		if used[j] {
+			continue
+		}
+		if err := c.Delete(cur); err != nil {
+			return err
+		}
+	}
+	for i, d := range desired {
+		if ids[i] != "" {
+			continue
+		}
+		added, err := c.Add(d)
+		if err != nil {
+			return err
+		}
+		ids[i] = added.ID()
+	}
+
+	items, err := c.List(template)
+	if err != nil {
+		return err
+	}
+	table := make([]string, len(items))
+	for i, item := range items {
+		table[i] = item.ID()
+	}
+	byID := make(map[string]client.Resource, len(desired))
+	for i, d := range desired {
+		d.SetID(ids[i])
+		byID[ids[i]] = d
+	}
+	for _, m := range utils.OrderingMoves(table, ids) {
+		if err := c.Move(byID[m.ID], m.Destination); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// sameProperties reports whether both items have the same values of all properties except ID.
+// Values are compared as sets of comma separated elements.
+func sameProperties(a, b client.Resource) bool {
+	propsA, propsB := marshaledProperties(a), marshaledProperties(b)
+	if len(propsA) != len(propsB) {
+		return false
+	}
+	for name, value := range propsA {
+		if other, ok := propsB[name]; !ok || other != value {
+			return false
+		}
+	}
+
+	return true
+}
+
+// canUpdateInPlace reports whether the current item can be turned into the desired one without unsetting properties.
+func canUpdateInPlace(current, desired client.Resource) bool {
+	desiredProps := marshaledProperties(desired)
+	for name := range marshaledProperties(current) {
+		if _, ok := desiredProps[name]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func marshaledProperties(r client.Resource) map[string]string {
+	props := map[string]string{}
+	for _, arg := range client.Marshal("", r)[1:] {
+		name, value, _ := strings.Cut(strings.TrimPrefix(arg, "="), "=")
+		if name == r.IDField() {
+			continue
+		}
+		elements := strings.Split(value, ",")
+		sort.Strings(elements)
+		props[name] = strings.Join(elements, ",")
+	}
+
+	return props
+}
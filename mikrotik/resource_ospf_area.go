@@ -0,0 +1,151 @@
+package mikrotik
+
+import (
+	"context"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ospfArea struct {
+	client *client.Mikrotik
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &ospfArea{}
+	_ resource.ResourceWithConfigure   = &ospfArea{}
+	_ resource.ResourceWithImportState = &ospfArea{}
+)
+
+// NewOspfAreaResource is a helper function to simplify the provider implementation.
+func NewOspfAreaResource() resource.Resource {
+	return &ospfArea{}
+}
+
+func (r *ospfArea) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*client.Mikrotik)
+}
+
+// Metadata returns the resource type name.
+func (r *ospfArea) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ospf_area"
+}
+
+// Schema defines the schema for the resource.
+func (s *ospfArea) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates a MikroTik OSPF area only supported by RouterOS v7+.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Unique ID of this resource.",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the OSPF area.",
+			},
+			"area_id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("0.0.0.0"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "OSPF area identifier in dotted decimal notation, `0.0.0.0` is the backbone area.",
+			},
+			"comment": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Comment to the OSPF area.",
+			},
+			"default_cost": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Cost of the default route originated into `stub` and `nssa` areas.",
+			},
+			"disabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the area is disabled.",
+			},
+			"instance": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the OSPF instance the area belongs to.",
+			},
+			"no_summaries": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether to stop sending summary LSAs into `stub` and `nssa` areas.",
+			},
+			"type": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("default"),
+				Description: "Type of the area: `default`, `stub` or `nssa`.",
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *ospfArea) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var terraformModel ospfAreaModel
+	var mikrotikModel client.OspfArea
+	GenericCreateResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ospfArea) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var terraformModel ospfAreaModel
+	var mikrotikModel client.OspfArea
+	GenericReadResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *ospfArea) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var terraformModel ospfAreaModel
+	var mikrotikModel client.OspfArea
+	GenericUpdateResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *ospfArea) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var terraformModel ospfAreaModel
+	var mikrotikModel client.OspfArea
+	GenericDeleteResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+func (r *ospfArea) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
+
+type ospfAreaModel struct {
+	Id          tftypes.String `tfsdk:"id"`
+	Name        tftypes.String `tfsdk:"name"`
+	AreaId      tftypes.String `tfsdk:"area_id"`
+	Comment     tftypes.String `tfsdk:"comment"`
+	DefaultCost tftypes.Int64  `tfsdk:"default_cost"`
+	Disabled    tftypes.Bool   `tfsdk:"disabled"`
+	Instance    tftypes.String `tfsdk:"instance"`
+	NoSummaries tftypes.Bool   `tfsdk:"no_summaries"`
+	Type        tftypes.String `tfsdk:"type"`
+}
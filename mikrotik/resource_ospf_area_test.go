@@ -0,0 +1,81 @@
+package mikrotik
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var terraformResourceTypeOspfArea string = "mikrotik_ospf_area"
+
+func TestOspfArea_basic(t *testing.T) {
+	client.SkipIfRouterOSV6OrEarlier(t, sysResources)
+	resourceName := terraformResourceTypeOspfArea + ".testacc"
+	name := "testacc-" + client.RandomString()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckOspfAreaDestroy,
+			testAccCheckOspfInstanceDestroy,
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOspfAreaConfig(name, "default"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", name+"-area"),
+					resource.TestCheckResourceAttrPair(resourceName, "instance", terraformResourceTypeOspfInstance+".testacc", "name"),
+					resource.TestCheckResourceAttr(resourceName, "area_id", "0.0.0.1"),
+					resource.TestCheckResourceAttr(resourceName, "type", "default"),
+				),
+			},
+			{
+				Config: testAccOspfAreaConfig(name, "stub"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "type", "stub"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     name + "-area",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckOspfAreaDestroy(s *terraform.State) error {
+	c := client.NewClient(client.GetConfigFromEnv())
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != terraformResourceTypeOspfArea {
+			continue
+		}
+
+		remoteRecord, err := c.FindOspfArea(rs.Primary.Attributes["name"])
+		if err != nil && !client.IsNotFoundError(err) {
+			return fmt.Errorf("expected not found error, got %+#v", err)
+		}
+
+		if remoteRecord != nil {
+			return fmt.Errorf("resource %T with id %q still exists in remote system", remoteRecord, remoteRecord.Id)
+		}
+	}
+	return nil
+}
+
+func testAccOspfAreaConfig(name, areaType string) string {
+	return testAccOspfInstanceConfig(name, "10.255.0.1") + fmt.Sprintf(`
+		resource "mikrotik_ospf_area" "testacc" {
+			name     = "%s-area"
+			area_id  = "0.0.0.1"
+			instance = mikrotik_ospf_instance.testacc.name
+			type     = %q
+		}
+	`, name, areaType)
+}
@@ -0,0 +1,164 @@
+package mikrotik
+
+import (
+	"context"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ospfInstance struct {
+	client *client.Mikrotik
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &ospfInstance{}
+	_ resource.ResourceWithConfigure   = &ospfInstance{}
+	_ resource.ResourceWithImportState = &ospfInstance{}
+)
+
+// NewOspfInstanceResource is a helper function to simplify the provider implementation.
+func NewOspfInstanceResource() resource.Resource {
+	return &ospfInstance{}
+}
+
+func (r *ospfInstance) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*client.Mikrotik)
+}
+
+// Metadata returns the resource type name.
+func (r *ospfInstance) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ospf_instance"
+}
+
+// Schema defines the schema for the resource.
+func (s *ospfInstance) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates a MikroTik OSPF instance only supported by RouterOS v7+.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Unique ID of this resource.",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the OSPF instance.",
+			},
+			"comment": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Comment to the OSPF instance.",
+			},
+			"disabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the instance is disabled.",
+			},
+			"in_filter_chain": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Name of the routing filter chain applied to the incoming routes.",
+			},
+			"originate_default": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether to originate the default route: `never`, `always` or `if-installed`.",
+			},
+			"out_filter_chain": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Name of the routing filter chain applied to the outgoing routes.",
+			},
+			"redistribute": schema.SetAttribute{
+				Optional:    true,
+				Computed:    true,
+				ElementType: tftypes.StringType,
+				Description: "Route types to redistribute into OSPF, e.g. `connected`, `static`, `bgp` or `copy`.",
+			},
+			"router_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "OSPF router ID or name of the router ID instance to use.",
+			},
+			"routing_table": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Routing table the OSPF routes are installed into.",
+			},
+			"version": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(2),
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Description: "OSPF version: `2` for IPv4 or `3` for IPv6.",
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *ospfInstance) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var terraformModel ospfInstanceModel
+	var mikrotikModel client.OspfInstance
+	GenericCreateResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ospfInstance) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var terraformModel ospfInstanceModel
+	var mikrotikModel client.OspfInstance
+	GenericReadResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *ospfInstance) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var terraformModel ospfInstanceModel
+	var mikrotikModel client.OspfInstance
+	GenericUpdateResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *ospfInstance) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var terraformModel ospfInstanceModel
+	var mikrotikModel client.OspfInstance
+	GenericDeleteResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+func (r *ospfInstance) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
+
+type ospfInstanceModel struct {
+	Id               tftypes.String `tfsdk:"id"`
+	Name             tftypes.String `tfsdk:"name"`
+	Comment          tftypes.String `tfsdk:"comment"`
+	Disabled         tftypes.Bool   `tfsdk:"disabled"`
+	InFilterChain    tftypes.String `tfsdk:"in_filter_chain"`
+	OriginateDefault tftypes.String `tfsdk:"originate_default"`
+	OutFilterChain   tftypes.String `tfsdk:"out_filter_chain"`
+	Redistribute     tftypes.Set    `tfsdk:"redistribute"`
+	RouterId         tftypes.String `tfsdk:"router_id"`
+	RoutingTable     tftypes.String `tfsdk:"routing_table"`
+	Version          tftypes.Int64  `tfsdk:"version"`
+}
@@ -0,0 +1,186 @@
+package mikrotik
+
+import (
+	"context"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/ddelnano/terraform-provider-mikrotik/mikrotik/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ospfInterfaceTemplate struct {
+	client *client.Mikrotik
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &ospfInterfaceTemplate{}
+	_ resource.ResourceWithConfigure   = &ospfInterfaceTemplate{}
+	_ resource.ResourceWithImportState = &ospfInterfaceTemplate{}
+)
+
+// NewOspfInterfaceTemplateResource is a helper function to simplify the provider implementation.
+func NewOspfInterfaceTemplateResource() resource.Resource {
+	return &ospfInterfaceTemplate{}
+}
+
+func (r *ospfInterfaceTemplate) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*client.Mikrotik)
+}
+
+// Metadata returns the resource type name.
+func (r *ospfInterfaceTemplate) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ospf_interface_template"
+}
+
+// Schema defines the schema for the resource.
+func (s *ospfInterfaceTemplate) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates a MikroTik OSPF interface template only supported by RouterOS v7+. OSPF runs on interfaces matching `interfaces` or having an address within `networks`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Unique ID of this resource.",
+			},
+			"area": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the OSPF area the matched interfaces belong to.",
+			},
+			"auth": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Authentication method: `simple`, `md5`, `sha1`, `sha256`, `sha384` or `sha512`.",
+			},
+			"auth_id": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Key ID used for authentication.",
+			},
+			"auth_key": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Key used for authentication.",
+			},
+			"comment": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Comment to the template.",
+			},
+			"cost": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Cost of the interfaces.",
+			},
+			"dead_interval": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Interval in seconds after which a silent neighbor is considered down.",
+			},
+			"disabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the template is disabled.",
+			},
+			"hello_interval": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Interval in seconds between hello packets.",
+			},
+			"interfaces": schema.SetAttribute{
+				Optional:    true,
+				Computed:    true,
+				ElementType: tftypes.StringType,
+				Description: "Interfaces or interface lists to match.",
+			},
+			"networks": schema.SetAttribute{
+				Optional:    true,
+				Computed:    true,
+				ElementType: tftypes.StringType,
+				Description: "Prefixes to match interface addresses against.",
+			},
+			"passive": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether to advertise the matched networks without sending hello packets.",
+			},
+			"priority": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Router priority used in the designated router election.",
+			},
+			"type": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Network type: `broadcast`, `nbma`, `ptp`, `ptmp`, `ptp-unnumbered` or `virtual-link`.",
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *ospfInterfaceTemplate) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var terraformModel ospfInterfaceTemplateModel
+	var mikrotikModel client.OspfInterfaceTemplate
+	GenericCreateResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ospfInterfaceTemplate) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var terraformModel ospfInterfaceTemplateModel
+	var mikrotikModel client.OspfInterfaceTemplate
+	GenericReadResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *ospfInterfaceTemplate) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var terraformModel ospfInterfaceTemplateModel
+	var mikrotikModel client.OspfInterfaceTemplate
+	GenericUpdateResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *ospfInterfaceTemplate) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var terraformModel ospfInterfaceTemplateModel
+	var mikrotikModel client.OspfInterfaceTemplate
+	GenericDeleteResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+func (r *ospfInterfaceTemplate) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	utils.ImportUppercaseWrapper(resource.ImportStatePassthroughID)(ctx, path.Root("id"), req, resp)
+}
+
+type ospfInterfaceTemplateModel struct {
+	Id            tftypes.String `tfsdk:"id"`
+	Area          tftypes.String `tfsdk:"area"`
+	Auth          tftypes.String `tfsdk:"auth"`
+	AuthId        tftypes.Int64  `tfsdk:"auth_id"`
+	AuthKey       tftypes.String `tfsdk:"auth_key"`
+	Comment       tftypes.String `tfsdk:"comment"`
+	Cost          tftypes.Int64  `tfsdk:"cost"`
+	DeadInterval  tftypes.Int64  `tfsdk:"dead_interval"`
+	Disabled      tftypes.Bool   `tfsdk:"disabled"`
+	HelloInterval tftypes.Int64  `tfsdk:"hello_interval"`
+	Interfaces    tftypes.Set    `tfsdk:"interfaces"`
+	Networks      tftypes.Set    `tfsdk:"networks"`
+	Passive       tftypes.Bool   `tfsdk:"passive"`
+	Priority      tftypes.Int64  `tfsdk:"priority"`
+	Type          tftypes.String `tfsdk:"type"`
+}
@@ -0,0 +1,87 @@
+package mikrotik
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var terraformResourceTypeOspfInterfaceTemplate string = "mikrotik_ospf_interface_template"
+
+func TestOspfInterfaceTemplate_basic(t *testing.T) {
+	client.SkipIfRouterOSV6OrEarlier(t, sysResources)
+	resourceName := terraformResourceTypeOspfInterfaceTemplate + ".testacc"
+	name := "testacc-" + client.RandomString()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckOspfInterfaceTemplateDestroy,
+			testAccCheckOspfAreaDestroy,
+			testAccCheckOspfInstanceDestroy,
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOspfInterfaceTemplateConfig(name, 10),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "area", terraformResourceTypeOspfArea+".testacc", "name"),
+					resource.TestCheckResourceAttr(resourceName, "cost", "10"),
+					resource.TestCheckResourceAttr(resourceName, "networks.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "networks.*", "10.80.0.0/24"),
+					resource.TestCheckResourceAttr(resourceName, "passive", "true"),
+				),
+			},
+			{
+				Config: testAccOspfInterfaceTemplateConfig(name, 20),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "cost", "20"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckOspfInterfaceTemplateDestroy(s *terraform.State) error {
+	c := client.NewClient(client.GetConfigFromEnv())
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != terraformResourceTypeOspfInterfaceTemplate {
+			continue
+		}
+
+		remoteRecord, err := c.FindOspfInterfaceTemplate(rs.Primary.ID)
+		if err != nil && !client.IsNotFoundError(err) {
+			return fmt.Errorf("expected not found error, got %+#v", err)
+		}
+
+		if remoteRecord != nil {
+			return fmt.Errorf("resource %T with id %q still exists in remote system", remoteRecord, remoteRecord.Id)
+		}
+	}
+	return nil
+}
+
+func testAccOspfInterfaceTemplateConfig(name string, cost int) string {
+	return testAccOspfInstanceConfig(name, "10.255.0.1") + fmt.Sprintf(`
+		resource "mikrotik_ospf_area" "testacc" {
+			name     = "%s-backbone"
+			instance = mikrotik_ospf_instance.testacc.name
+		}
+
+		resource "mikrotik_ospf_interface_template" "testacc" {
+			area     = mikrotik_ospf_area.testacc.name
+			cost     = %d
+			networks = ["10.80.0.0/24"]
+			passive  = true
+		}
+	`, name, cost)
+}
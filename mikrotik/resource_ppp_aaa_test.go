@@ -0,0 +1,83 @@
+package mikrotik
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccMikrotikPppAaa_basic(t *testing.T) {
+	resourceName := "mikrotik_ppp_aaa.settings"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMikrotikPppAaaDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPppAaa(true, 0),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "/ppp/aaa"),
+					resource.TestCheckResourceAttr(resourceName, "use_radius", "true"),
+					resource.TestCheckResourceAttr(resourceName, "accounting", "true"),
+					resource.TestCheckResourceAttr(resourceName, "interim_update", "0"),
+				),
+			},
+			{
+				Config: testAccPppAaa(false, 600),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "accounting", "false"),
+					resource.TestCheckResourceAttr(resourceName, "interim_update", "600"),
+				),
+			},
+			{
+				Config: `
+resource "mikrotik_ppp_aaa" "settings" {
+    interim_update = 300
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "use_radius", "false"),
+					resource.TestCheckResourceAttr(resourceName, "interim_update", "300"),
+				),
+			},
+			{
+				ImportState:       true,
+				ResourceName:      resourceName,
+				ImportStateId:     "/ppp/aaa",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccPppAaa(accounting bool, interimUpdate int) string {
+	return fmt.Sprintf(`
+resource "mikrotik_ppp_aaa" "settings" {
+    use_radius     = true
+    accounting     = %t
+    interim_update = %d
+}
+`, accounting, interimUpdate)
+}
+
+func testAccCheckMikrotikPppAaaDestroy(s *terraform.State) error {
+	c := client.NewClient(client.GetConfigFromEnv())
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "mikrotik_ppp_aaa" {
+			continue
+		}
+
+		settings, err := c.FindPppAaa()
+		if err != nil {
+			return err
+		}
+
+		if settings.UseRadius || !settings.Accounting {
+			return fmt.Errorf("PPP AAA settings were not restored to defaults: %+v", settings)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+package mikrotik
+
+import (
+	"context"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/ddelnano/terraform-provider-mikrotik/mikrotik/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type radius struct {
+	client *client.Mikrotik
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &radius{}
+	_ resource.ResourceWithConfigure   = &radius{}
+	_ resource.ResourceWithImportState = &radius{}
+)
+
+// NewRadiusResource is a helper function to simplify the provider implementation.
+func NewRadiusResource() resource.Resource {
+	return &radius{}
+}
+
+func (r *radius) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*client.Mikrotik)
+}
+
+// Metadata returns the resource type name.
+func (r *radius) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_radius"
+}
+
+// Schema defines the schema for the resource.
+func (s *radius) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Adds a RADIUS server used by the MikroTik device to authenticate and account users of the selected services.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Unique ID of this resource.",
+			},
+			"accounting_port": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "RADIUS server port used for accounting.",
+			},
+			"address": schema.StringAttribute{
+				Required:    true,
+				Description: "Address of the RADIUS server.",
+			},
+			"authentication_port": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "RADIUS server port used for authentication.",
+			},
+			"comment": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Comment to the RADIUS server.",
+			},
+			"disabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the RADIUS server is disabled.",
+			},
+			"secret": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "Shared secret used to access the RADIUS server.",
+			},
+			"service": schema.SetAttribute{
+				Required:    true,
+				ElementType: tftypes.StringType,
+				Description: "Services using the RADIUS server, e.g. `login`, `ppp`, `hotspot`, `wireless`, `dhcp` or `ipsec`.",
+			},
+			"src_address": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Source address of packets sent to the RADIUS server.",
+			},
+			"timeout": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Time to wait for a reply from the RADIUS server, e.g. `300ms` or `1s`.",
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *radius) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var terraformModel radiusModel
+	var mikrotikModel client.Radius
+	GenericCreateResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *radius) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var terraformModel radiusModel
+	var mikrotikModel client.Radius
+	GenericReadResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *radius) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var terraformModel radiusModel
+	var mikrotikModel client.Radius
+	GenericUpdateResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *radius) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var terraformModel radiusModel
+	var mikrotikModel client.Radius
+	GenericDeleteResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+func (r *radius) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	utils.ImportUppercaseWrapper(resource.ImportStatePassthroughID)(ctx, path.Root("id"), req, resp)
+}
+
+type radiusModel struct {
+	Id                 tftypes.String `tfsdk:"id"`
+	AccountingPort     tftypes.Int64  `tfsdk:"accounting_port"`
+	Address            tftypes.String `tfsdk:"address"`
+	AuthenticationPort tftypes.Int64  `tfsdk:"authentication_port"`
+	Comment            tftypes.String `tfsdk:"comment"`
+	Disabled           tftypes.Bool   `tfsdk:"disabled"`
+	Secret             tftypes.String `tfsdk:"secret"`
+	Service            tftypes.Set    `tfsdk:"service"`
+	SrcAddress         tftypes.String `tfsdk:"src_address"`
+	Timeout            tftypes.String `tfsdk:"timeout"`
+}
@@ -0,0 +1,78 @@
+package mikrotik
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var terraformResourceTypeRadius string = "mikrotik_radius"
+
+func TestRadius_basic(t *testing.T) {
+	resourceName := terraformResourceTypeRadius + ".testacc"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRadiusDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRadiusConfig(`["login", "ppp"]`, "300ms"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "address", "192.168.88.20"),
+					resource.TestCheckResourceAttr(resourceName, "secret", "testacc-secret"),
+					resource.TestCheckResourceAttr(resourceName, "service.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "service.*", "ppp"),
+					resource.TestCheckResourceAttr(resourceName, "timeout", "300ms"),
+				),
+			},
+			{
+				Config: testAccRadiusConfig(`["login"]`, "1s"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "service.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "service.*", "login"),
+					resource.TestCheckResourceAttr(resourceName, "timeout", "1s"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckRadiusDestroy(s *terraform.State) error {
+	c := client.NewClient(client.GetConfigFromEnv())
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != terraformResourceTypeRadius {
+			continue
+		}
+
+		remoteRecord, err := c.FindRadius(rs.Primary.ID)
+		if err != nil && !client.IsNotFoundError(err) {
+			return fmt.Errorf("expected not found error, got %+#v", err)
+		}
+
+		if remoteRecord != nil {
+			return fmt.Errorf("resource %T with id %q still exists in remote system", remoteRecord, remoteRecord.Id)
+		}
+	}
+	return nil
+}
+
+func testAccRadiusConfig(service, timeout string) string {
+	return fmt.Sprintf(`
+		resource "mikrotik_radius" "testacc" {
+			address = "192.168.88.20"
+			secret  = "testacc-secret"
+			service = %s
+			timeout = %q
+		}
+	`, service, timeout)
+}
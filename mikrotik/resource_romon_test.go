@@ -0,0 +1,71 @@
+package mikrotik
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccMikrotikRomon_basic(t *testing.T) {
+	resourceName := "mikrotik_romon.settings"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMikrotikRomonDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "mikrotik_romon" "settings" {
+						enabled = true
+						secrets = ["testacc-secret"]
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "/tool/romon"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "romon_id", "00:00:00:00:00:00"),
+					resource.TestCheckResourceAttr(resourceName, "secrets.#", "1"),
+				),
+			},
+			{
+				Config: `
+					resource "mikrotik_romon" "settings" {
+						secrets = []
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "secrets.#", "0"),
+				),
+			},
+			{
+				ImportState:       true,
+				ResourceName:      resourceName,
+				ImportStateId:     "/tool/romon",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckMikrotikRomonDestroy(s *terraform.State) error {
+	c := client.NewClient(client.GetConfigFromEnv())
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "mikrotik_romon" {
+			continue
+		}
+
+		settings, err := c.FindRomon()
+		if err != nil {
+			return err
+		}
+
+		if settings.Enabled || len(settings.Secrets) > 0 {
+			return fmt.Errorf("RoMON settings were not restored to defaults: %+v", settings)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,220 @@
+package mikrotik
+
+import (
+	"context"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/ddelnano/terraform-provider-mikrotik/mikrotik/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type routingFilterChain struct {
+	client *client.Mikrotik
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &routingFilterChain{}
+	_ resource.ResourceWithConfigure   = &routingFilterChain{}
+	_ resource.ResourceWithImportState = &routingFilterChain{}
+)
+
+// NewRoutingFilterChainResource is a helper function to simplify the provider implementation.
+func NewRoutingFilterChainResource() resource.Resource {
+	return &routingFilterChain{}
+}
+
+func (r *routingFilterChain) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*client.Mikrotik)
+}
+
+// Metadata returns the resource type name.
+func (r *routingFilterChain) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_routing_filter_chain"
+}
+
+// Schema defines the schema for the resource.
+func (s *routingFilterChain) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages all rules of a MikroTik routing filter chain as an ordered list, only supported by RouterOS v7+. Rules of the chain which are not listed in the configuration are removed.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Unique ID of this resource.",
+			},
+			"chain": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "The chain which rules are managed.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"rule": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"comment": schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Default:     stringdefault.StaticString(""),
+							Description: "Comment to the rule.",
+						},
+						"disabled": schema.BoolAttribute{
+							Optional:    true,
+							Computed:    true,
+							Default:     booldefault.StaticBool(false),
+							Description: "Whether the rule is disabled.",
+						},
+						"rule": schema.StringAttribute{
+							Required:    true,
+							Description: "Script-like rule body, e.g. `if (dst in 10.0.0.0/8) { reject; }`.",
+						},
+					},
+				},
+				Description: "Rules of the chain in the order they are evaluated.",
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *routingFilterChain) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var terraformModel routingFilterChainModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &terraformModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &terraformModel, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	terraformModel.Id = terraformModel.Chain
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &terraformModel)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *routingFilterChain) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var terraformModel routingFilterChainModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &terraformModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.read(ctx, &terraformModel, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &terraformModel)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *routingFilterChain) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var terraformModel routingFilterChainModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &terraformModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &terraformModel, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &terraformModel)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *routingFilterChain) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var terraformModel routingFilterChainModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &terraformModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := reconcileOrderedList(r.client, &client.RoutingFilterRule{}, terraformModel.owns, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Cannot remove routing filter rules", err.Error())
+	}
+}
+
+func (r *routingFilterChain) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("chain"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("rule"), []routingFilterChainRuleModel{})...)
+}
+
+// apply reconciles the rules on the remote system with the model.
+//
+// The planned rules are kept in the model, as all their attributes are known at plan time.
+func (r *routingFilterChain) apply(ctx context.Context, m *routingFilterChainModel, diags *diag.Diagnostics) {
+	desired := make([]client.Resource, len(m.Rules))
+	for i := range m.Rules {
+		rule := client.RoutingFilterRule{}
+		if err := utils.TerraformModelToMikrotikStruct(ctx, &m.Rules[i], &rule); err != nil {
+			diags.AddError("Cannot copy model: Terraform -> MikroTik", err.Error())
+			return
+		}
+		rule.Chain = m.Chain.ValueString()
+		desired[i] = &rule
+	}
+
+	if err := reconcileOrderedList(r.client, &client.RoutingFilterRule{}, m.owns, desired); err != nil {
+		diags.AddError("Cannot apply routing filter rules", err.Error())
+	}
+}
+
+// read replaces rules in the model with the ones found on the remote system.
+func (r *routingFilterChain) read(ctx context.Context, m *routingFilterChainModel, diags *diag.Diagnostics) {
+	found, err := listOwnedItems(r.client, &client.RoutingFilterRule{}, m.owns)
+	if err != nil {
+		diags.AddError("Error reading remote resource", err.Error())
+		return
+	}
+
+	m.Rules = make([]routingFilterChainRuleModel, len(found))
+	for i, f := range found {
+		if err := utils.MikrotikStructToTerraformModel(ctx, f, &m.Rules[i]); err != nil {
+			diags.AddError("Cannot copy model: MikroTik -> Terraform", err.Error())
+			return
+		}
+	}
+}
+
+type routingFilterChainModel struct {
+	Id    tftypes.String                `tfsdk:"id"`
+	Chain tftypes.String                `tfsdk:"chain"`
+	Rules []routingFilterChainRuleModel `tfsdk:"rule"`
+}
+
+// owns reports whether the rule is managed by the chain resource.
+func (m routingFilterChainModel) owns(r client.Resource) bool {
+	rule := r.(*client.RoutingFilterRule)
+
+	return !rule.Dynamic && rule.Chain == m.Chain.ValueString()
+}
+
+type routingFilterChainRuleModel struct {
+	Comment  tftypes.String `tfsdk:"comment"`
+	Disabled tftypes.Bool   `tfsdk:"disabled"`
+	Rule     tftypes.String `tfsdk:"rule"`
+}
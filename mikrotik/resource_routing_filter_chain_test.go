@@ -0,0 +1,93 @@
+package mikrotik
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestRoutingFilterChain_basic(t *testing.T) {
+	client.SkipIfRouterOSV6OrEarlier(t, sysResources)
+	resourceName := "mikrotik_routing_filter_chain.testacc"
+	chain := "testacc-chain"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRoutingFilterChainRules(chain),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoutingFilterChainConfig(chain, []string{"10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16"}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", chain),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "3"),
+					testAccCheckRoutingFilterChainRules(chain,
+						"if (dst in 10.0.0.0/8) { reject; }",
+						"if (dst in 172.16.0.0/12) { reject; }",
+						"if (dst in 192.168.0.0/16) { reject; }",
+					),
+				),
+			},
+			{
+				Config: testAccRoutingFilterChainConfig(chain, []string{"192.168.0.0/16", "10.0.0.0/8"}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "2"),
+					testAccCheckRoutingFilterChainRules(chain,
+						"if (dst in 192.168.0.0/16) { reject; }",
+						"if (dst in 10.0.0.0/8) { reject; }",
+					),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// testAccCheckRoutingFilterChainRules ensures that the chain consists of given rules in the same order
+func testAccCheckRoutingFilterChainRules(chain string, expected ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		c := client.NewClient(client.GetConfigFromEnv())
+		rules, err := c.ListRoutingFilterRules()
+		if err != nil {
+			return err
+		}
+
+		actual := []string{}
+		for _, r := range rules {
+			if r.Chain == chain {
+				actual = append(actual, r.Rule)
+			}
+		}
+		if strings.Join(actual, "\n") != strings.Join(expected, "\n") {
+			return fmt.Errorf("expected rules %q in chain %q, got %q", expected, chain, actual)
+		}
+
+		return nil
+	}
+}
+
+func testAccRoutingFilterChainConfig(chain string, prefixes []string) string {
+	rules := ""
+	for _, prefix := range prefixes {
+		rules += fmt.Sprintf(`
+			rule {
+				rule = "if (dst in %s) { reject; }"
+			}
+		`, prefix)
+	}
+
+	return fmt.Sprintf(`
+		resource "mikrotik_routing_filter_chain" "testacc" {
+			chain = %q
+			%s
+		}
+	`, chain, rules)
+}
@@ -0,0 +1,75 @@
+package mikrotik
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var terraformResourceTypeRoutingFilterRule string = "mikrotik_routing_filter_rule"
+
+func TestRoutingFilterRule_basic(t *testing.T) {
+	client.SkipIfRouterOSV6OrEarlier(t, sysResources)
+	resourceName := terraformResourceTypeRoutingFilterRule + ".testacc"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRoutingFilterRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoutingFilterRuleConfig("if (dst in 10.0.0.0/8) { reject; }"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "chain", "testacc-in"),
+					resource.TestCheckResourceAttr(resourceName, "rule", "if (dst in 10.0.0.0/8) { reject; }"),
+				),
+			},
+			{
+				Config: testAccRoutingFilterRuleConfig("if (dst-len > 24) { reject; } else { accept; }"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rule", "if (dst-len > 24) { reject; } else { accept; }"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"place_before"},
+			},
+		},
+	})
+}
+
+func testAccCheckRoutingFilterRuleDestroy(s *terraform.State) error {
+	c := client.NewClient(client.GetConfigFromEnv())
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != terraformResourceTypeRoutingFilterRule {
+			continue
+		}
+
+		remoteRecord, err := c.FindRoutingFilterRule(rs.Primary.ID)
+		if err != nil && !client.IsNotFoundError(err) {
+			return fmt.Errorf("expected not found error, got %+#v", err)
+		}
+
+		if remoteRecord != nil {
+			return fmt.Errorf("resource %T with id %q still exists in remote system", remoteRecord, remoteRecord.Id)
+		}
+	}
+	return nil
+}
+
+func testAccRoutingFilterRuleConfig(rule string) string {
+	return fmt.Sprintf(`
+		resource "mikrotik_routing_filter_rule" "testacc" {
+			chain    = "testacc-in"
+			comment  = "testacc rule"
+			rule     = %q
+			disabled = true
+		}
+	`, rule)
+}
@@ -0,0 +1,172 @@
+package mikrotik
+
+import (
+	"context"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/ddelnano/terraform-provider-mikrotik/mikrotik/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type routingRule struct {
+	client *client.Mikrotik
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &routingRule{}
+	_ resource.ResourceWithConfigure   = &routingRule{}
+	_ resource.ResourceWithImportState = &routingRule{}
+)
+
+// NewRoutingRuleResource is a helper function to simplify the provider implementation.
+func NewRoutingRuleResource() resource.Resource {
+	return &routingRule{}
+}
+
+func (r *routingRule) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*client.Mikrotik)
+}
+
+// Metadata returns the resource type name.
+func (r *routingRule) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_routing_rule"
+}
+
+// Schema defines the schema for the resource.
+func (s *routingRule) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates a MikroTik policy routing rule only supported by RouterOS v7+. Rules are evaluated in order, the first matching rule decides which routing table is used.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Unique ID of this resource.",
+			},
+			"action": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("lookup"),
+				Description: "Action to take if packet is matched by the rule: `lookup`, `lookup-only-in-table`, `drop` or `unreachable`.",
+			},
+			"comment": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Comment to the rule.",
+			},
+			"disabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the rule is disabled.",
+			},
+			"dst_address": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Matches packets with destination address within the specified prefix.",
+			},
+			"interface": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Matches packets which entered the router via the specified interface.",
+			},
+			"min_prefix": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Ignores routes with prefix length less than or equal to the value found in the routing table.",
+			},
+			"place_before": placeBeforeAttribute(),
+			"routing_mark": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Matches packets marked by mangle facility with particular routing mark.",
+			},
+			"src_address": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Matches packets with source address within the specified prefix.",
+			},
+			"table": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Name of the routing table to look up the route in. Applicable for `lookup` and `lookup-only-in-table` actions.",
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *routingRule) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var terraformModel routingRuleModel
+	var mikrotikModel client.RoutingRule
+	GenericCreateResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	applyPlaceBefore(r.client, &client.RoutingRule{Id: terraformModel.Id.ValueString()}, terraformModel.PlaceBefore, &resp.Diagnostics)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *routingRule) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var terraformModel routingRuleModel
+	var mikrotikModel client.RoutingRule
+	GenericReadResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	refreshPlaceBefore(ctx, r.client, &client.RoutingRule{Id: terraformModel.Id.ValueString()}, terraformModel.PlaceBefore, &resp.State, &resp.Diagnostics)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *routingRule) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var terraformModel routingRuleModel
+	var mikrotikModel client.RoutingRule
+	GenericUpdateResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	applyPlaceBefore(r.client, &client.RoutingRule{Id: terraformModel.Id.ValueString()}, terraformModel.PlaceBefore, &resp.Diagnostics)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *routingRule) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var terraformModel routingRuleModel
+	var mikrotikModel client.RoutingRule
+	GenericDeleteResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+func (r *routingRule) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	utils.ImportUppercaseWrapper(resource.ImportStatePassthroughID)(ctx, path.Root("id"), req, resp)
+}
+
+type routingRuleModel struct {
+	Id          tftypes.String `tfsdk:"id"`
+	Action      tftypes.String `tfsdk:"action"`
+	Comment     tftypes.String `tfsdk:"comment"`
+	Disabled    tftypes.Bool   `tfsdk:"disabled"`
+	DstAddress  tftypes.String `tfsdk:"dst_address"`
+	Interface   tftypes.String `tfsdk:"interface"`
+	MinPrefix   tftypes.Int64  `tfsdk:"min_prefix"`
+	PlaceBefore tftypes.String `tfsdk:"place_before"`
+	RoutingMark tftypes.String `tfsdk:"routing_mark"`
+	SrcAddress  tftypes.String `tfsdk:"src_address"`
+	Table       tftypes.String `tfsdk:"table"`
+}
@@ -0,0 +1,81 @@
+package mikrotik
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var terraformResourceTypeRoutingRule string = "mikrotik_routing_rule"
+
+func TestRoutingRule_basic(t *testing.T) {
+	client.SkipIfRouterOSV6OrEarlier(t, sysResources)
+	resourceName := terraformResourceTypeRoutingRule + ".testacc"
+	tableName := "testacc-" + client.RandomString()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRoutingRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoutingRuleConfig(tableName, "10.79.0.0/24"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "action", "lookup-only-in-table"),
+					resource.TestCheckResourceAttr(resourceName, "src_address", "10.79.0.0/24"),
+					resource.TestCheckResourceAttr(resourceName, "table", tableName),
+				),
+			},
+			{
+				Config: testAccRoutingRuleConfig(tableName, "10.79.1.0/24"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "src_address", "10.79.1.0/24"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"place_before"},
+			},
+		},
+	})
+}
+
+func testAccCheckRoutingRuleDestroy(s *terraform.State) error {
+	c := client.NewClient(client.GetConfigFromEnv())
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != terraformResourceTypeRoutingRule {
+			continue
+		}
+
+		remoteRecord, err := c.FindRoutingRule(rs.Primary.ID)
+		if err != nil && !client.IsNotFoundError(err) {
+			return fmt.Errorf("expected not found error, got %+#v", err)
+		}
+
+		if remoteRecord != nil {
+			return fmt.Errorf("resource %T with id %q still exists in remote system", remoteRecord, remoteRecord.Id)
+		}
+	}
+	return nil
+}
+
+func testAccRoutingRuleConfig(tableName, srcAddress string) string {
+	return fmt.Sprintf(`
+		resource "mikrotik_routing_table" "testacc" {
+			name = %q
+		}
+
+		resource "mikrotik_routing_rule" "testacc" {
+			action      = "lookup-only-in-table"
+			src_address = %q
+			table       = mikrotik_routing_table.testacc.name
+			disabled    = true
+		}
+	`, tableName, srcAddress)
+}
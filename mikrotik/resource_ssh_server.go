@@ -0,0 +1,130 @@
+package mikrotik
+
+import (
+	"context"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type sshServer struct {
+	client *client.Mikrotik
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &sshServer{}
+	_ resource.ResourceWithConfigure   = &sshServer{}
+	_ resource.ResourceWithImportState = &sshServer{}
+)
+
+// NewSshServerResource is a helper function to simplify the provider implementation.
+func NewSshServerResource() resource.Resource {
+	return &sshServer{}
+}
+
+func (r *sshServer) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*client.Mikrotik)
+}
+
+// Metadata returns the resource type name.
+func (r *sshServer) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ssh_server"
+}
+
+// Schema defines the schema for the resource.
+func (s *sshServer) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages SSH server settings of the MikroTik device. Destroying the resource restores default settings, except the host key type.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Unique ID of this resource.",
+			},
+			"allow_none_crypto": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether to allow connections without encryption.",
+			},
+			"always_allow_password_login": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether to allow password login for users with public keys.",
+			},
+			"forwarding_enabled": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "SSH forwarding: `no`, `local`, `remote` or `both` on RouterOS v7, `yes` or `no` on RouterOS v6.",
+			},
+			"host_key_type": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Type of the host key: `rsa` or `ed25519`. Changing it regenerates the host key. Supported on RouterOS v7 only.",
+			},
+			"strong_crypto": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether to use stronger ciphers, MACs and key exchange algorithms only.",
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *sshServer) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var terraformModel sshServerModel
+	var mikrotikModel client.SshServer
+	GenericCreateSingleton(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *sshServer) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var terraformModel sshServerModel
+	var mikrotikModel client.SshServer
+	GenericReadSingleton(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *sshServer) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var terraformModel sshServerModel
+	var mikrotikModel client.SshServer
+	GenericUpdateSingleton(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *sshServer) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var terraformModel sshServerModel
+	var mikrotikModel client.SshServer
+	GenericDeleteSingleton(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+func (r *sshServer) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+type sshServerModel struct {
+	Id                       tftypes.String `tfsdk:"id"`
+	AllowNoneCrypto          tftypes.Bool   `tfsdk:"allow_none_crypto"`
+	AlwaysAllowPasswordLogin tftypes.Bool   `tfsdk:"always_allow_password_login"`
+	ForwardingEnabled        tftypes.String `tfsdk:"forwarding_enabled"`
+	HostKeyType              tftypes.String `tfsdk:"host_key_type"`
+	StrongCrypto             tftypes.Bool   `tfsdk:"strong_crypto"`
+}
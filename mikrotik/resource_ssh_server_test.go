@@ -0,0 +1,58 @@
+package mikrotik
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccMikrotikSshServer_basic(t *testing.T) {
+	resourceName := "mikrotik_ssh_server.settings"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMikrotikSshServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "mikrotik_ssh_server" "settings" {
+						strong_crypto = true
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "/ip/ssh"),
+					resource.TestCheckResourceAttr(resourceName, "strong_crypto", "true"),
+					resource.TestCheckResourceAttr(resourceName, "allow_none_crypto", "false"),
+				),
+			},
+			{
+				ImportState:       true,
+				ResourceName:      resourceName,
+				ImportStateId:     "/ip/ssh",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckMikrotikSshServerDestroy(s *terraform.State) error {
+	c := client.NewClient(client.GetConfigFromEnv())
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "mikrotik_ssh_server" {
+			continue
+		}
+
+		settings, err := c.FindSshServer()
+		if err != nil {
+			return err
+		}
+
+		if settings.StrongCrypto {
+			return fmt.Errorf("SSH server settings were not restored to defaults: %+v", settings)
+		}
+	}
+	return nil
+}
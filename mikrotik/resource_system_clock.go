@@ -0,0 +1,115 @@
+package mikrotik
+
+import (
+	"context"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type systemClock struct {
+	client *client.Mikrotik
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &systemClock{}
+	_ resource.ResourceWithConfigure   = &systemClock{}
+	_ resource.ResourceWithImportState = &systemClock{}
+)
+
+// NewSystemClockResource is a helper function to simplify the provider implementation.
+func NewSystemClockResource() resource.Resource {
+	return &systemClock{}
+}
+
+func (r *systemClock) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*client.Mikrotik)
+}
+
+// Metadata returns the resource type name.
+func (r *systemClock) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_system_clock"
+}
+
+// Schema defines the schema for the resource.
+func (s *systemClock) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages time zone settings of the MikroTik device. Destroying the resource re-enables time zone autodetection.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Unique ID of this resource.",
+			},
+			"time_zone_name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Name of the time zone, e.g. `Europe/Riga`. Use `manual` to rely on `gmt-offset` configured on the device.",
+			},
+			"time_zone_autodetect": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Detect time zone automatically based on public IP address of the device.",
+			},
+			"gmt_offset": schema.StringAttribute{
+				Computed:    true,
+				Description: "Current offset from GMT.",
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *systemClock) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var terraformModel systemClockModel
+	var mikrotikModel client.SystemClock
+	GenericCreateSingleton(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *systemClock) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var terraformModel systemClockModel
+	var mikrotikModel client.SystemClock
+	GenericReadSingleton(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *systemClock) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var terraformModel systemClockModel
+	var mikrotikModel client.SystemClock
+	GenericUpdateSingleton(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *systemClock) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var terraformModel systemClockModel
+	var mikrotikModel client.SystemClock
+	GenericDeleteSingleton(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+func (r *systemClock) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+type systemClockModel struct {
+	Id                 tftypes.String `tfsdk:"id"`
+	TimeZoneName       tftypes.String `tfsdk:"time_zone_name"`
+	TimeZoneAutodetect tftypes.Bool   `tfsdk:"time_zone_autodetect"`
+	GmtOffset          tftypes.String `tfsdk:"gmt_offset"`
+}
@@ -0,0 +1,62 @@
+package mikrotik
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccMikrotikSystemClock_basic(t *testing.T) {
+	resourceName := "mikrotik_system_clock.clock"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMikrotikSystemClockDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSystemClock("Europe/Riga"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "/system/clock"),
+					resource.TestCheckResourceAttr(resourceName, "time_zone_name", "Europe/Riga"),
+					resource.TestCheckResourceAttr(resourceName, "time_zone_autodetect", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "gmt_offset"),
+				),
+			},
+			{
+				Config: testAccSystemClock("America/New_York"),
+				Check:  resource.TestCheckResourceAttr(resourceName, "time_zone_name", "America/New_York"),
+			},
+		},
+	})
+}
+
+func testAccSystemClock(timeZone string) string {
+	return fmt.Sprintf(`
+resource "mikrotik_system_clock" "clock" {
+    time_zone_name       = %q
+    time_zone_autodetect = false
+}
+`, timeZone)
+}
+
+func testAccCheckMikrotikSystemClockDestroy(s *terraform.State) error {
+	c := client.NewClient(client.GetConfigFromEnv())
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "mikrotik_system_clock" {
+			continue
+		}
+
+		clock, err := c.FindSystemClock()
+		if err != nil {
+			return err
+		}
+
+		if !clock.TimeZoneAutodetect {
+			return fmt.Errorf("time zone autodetection was not restored")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package mikrotik
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccMikrotikSystemIdentity_basic(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-acc-router")
+	updatedName := acctest.RandomWithPrefix("tf-acc-router")
+
+	resourceName := "mikrotik_system_identity.identity"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMikrotikSystemIdentityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSystemIdentity(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "/system/identity"),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+				),
+			},
+			{
+				Config: testAccSystemIdentity(updatedName),
+				Check:  resource.TestCheckResourceAttr(resourceName, "name", updatedName),
+			},
+			{
+				ImportState:       true,
+				ResourceName:      resourceName,
+				ImportStateId:     "/system/identity",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccSystemIdentity(name string) string {
+	return fmt.Sprintf(`
+resource "mikrotik_system_identity" "identity" {
+    name = %q
+}
+`, name)
+}
+
+func testAccCheckMikrotikSystemIdentityDestroy(s *terraform.State) error {
+	c := client.NewClient(client.GetConfigFromEnv())
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "mikrotik_system_identity" {
+			continue
+		}
+
+		identity, err := c.FindSystemIdentity()
+		if err != nil {
+			return err
+		}
+
+		if identity.Name != "MikroTik" {
+			return fmt.Errorf("system identity was not restored to default, got %q", identity.Name)
+		}
+	}
+	return nil
+}
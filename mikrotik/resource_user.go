@@ -0,0 +1,140 @@
+package mikrotik
+
+import (
+	"context"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type user struct {
+	client *client.Mikrotik
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &user{}
+	_ resource.ResourceWithConfigure   = &user{}
+	_ resource.ResourceWithImportState = &user{}
+)
+
+// NewUserResource is a helper function to simplify the provider implementation.
+func NewUserResource() resource.Resource {
+	return &user{}
+}
+
+func (r *user) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*client.Mikrotik)
+}
+
+// Metadata returns the resource type name.
+func (r *user) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+// Schema defines the schema for the resource.
+func (s *user) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates a MikroTik system user.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Unique ID of this resource.",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the user.",
+			},
+			"address": schema.SetAttribute{
+				Optional:    true,
+				Computed:    true,
+				ElementType: tftypes.StringType,
+				Default:     setdefault.StaticValue(tftypes.SetValueMust(tftypes.StringType, []attr.Value{})),
+				Description: "Addresses or networks the user is allowed to log in from. If not set, login from any address is allowed.",
+			},
+			"comment": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Comment to the user.",
+			},
+			"disabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the user is disabled.",
+			},
+			"group": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the group the user belongs to, e.g. `read`, `write`, `full` or a custom `mikrotik_user_group`.",
+			},
+			"password": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				Description: "Password of the user. RouterOS never reports the password back, so changes made outside of Terraform are not detected " +
+					"and the password is not set on import.",
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *user) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var terraformModel userModel
+	var mikrotikModel client.User
+	GenericCreateResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+	resp.Diagnostics.Append(keepWriteOnlyAttribute(ctx, path.Root("password"), req.Plan, &resp.State)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *user) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var terraformModel userModel
+	var mikrotikModel client.User
+	GenericReadResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+	resp.Diagnostics.Append(keepWriteOnlyAttribute(ctx, path.Root("password"), req.State, &resp.State)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *user) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var terraformModel userModel
+	var mikrotikModel client.User
+	GenericUpdateResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+	resp.Diagnostics.Append(keepWriteOnlyAttribute(ctx, path.Root("password"), req.Plan, &resp.State)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *user) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var terraformModel userModel
+	var mikrotikModel client.User
+	GenericDeleteResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+func (r *user) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
+
+type userModel struct {
+	Id       tftypes.String `tfsdk:"id"`
+	Name     tftypes.String `tfsdk:"name"`
+	Address  tftypes.Set    `tfsdk:"address"`
+	Comment  tftypes.String `tfsdk:"comment"`
+	Disabled tftypes.Bool   `tfsdk:"disabled"`
+	Group    tftypes.String `tfsdk:"group"`
+	Password tftypes.String `tfsdk:"password"`
+}
@@ -0,0 +1,83 @@
+package mikrotik
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccMikrotikUserAaa_basic(t *testing.T) {
+	resourceName := "mikrotik_user_aaa.settings"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMikrotikUserAaaDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserAaa("read", 0),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "/user/aaa"),
+					resource.TestCheckResourceAttr(resourceName, "use_radius", "true"),
+					resource.TestCheckResourceAttr(resourceName, "default_group", "read"),
+					resource.TestCheckResourceAttr(resourceName, "interim_update", "0"),
+				),
+			},
+			{
+				Config: testAccUserAaa("write", 300),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "default_group", "write"),
+					resource.TestCheckResourceAttr(resourceName, "interim_update", "300"),
+				),
+			},
+			{
+				Config: `
+resource "mikrotik_user_aaa" "settings" {
+    interim_update = 300
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "use_radius", "false"),
+					resource.TestCheckResourceAttr(resourceName, "interim_update", "300"),
+				),
+			},
+			{
+				ImportState:       true,
+				ResourceName:      resourceName,
+				ImportStateId:     "/user/aaa",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccUserAaa(defaultGroup string, interimUpdate int) string {
+	return fmt.Sprintf(`
+resource "mikrotik_user_aaa" "settings" {
+    use_radius     = true
+    default_group  = %q
+    interim_update = %d
+}
+`, defaultGroup, interimUpdate)
+}
+
+func testAccCheckMikrotikUserAaaDestroy(s *terraform.State) error {
+	c := client.NewClient(client.GetConfigFromEnv())
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "mikrotik_user_aaa" {
+			continue
+		}
+
+		settings, err := c.FindUserAaa()
+		if err != nil {
+			return err
+		}
+
+		if settings.UseRadius || settings.DefaultGroup != "read" {
+			return fmt.Errorf("user AAA settings were not restored to defaults: %+v", settings)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+package mikrotik
+
+import (
+	"context"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type userGroup struct {
+	client *client.Mikrotik
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &userGroup{}
+	_ resource.ResourceWithConfigure   = &userGroup{}
+	_ resource.ResourceWithImportState = &userGroup{}
+)
+
+// NewUserGroupResource is a helper function to simplify the provider implementation.
+func NewUserGroupResource() resource.Resource {
+	return &userGroup{}
+}
+
+func (r *userGroup) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*client.Mikrotik)
+}
+
+// Metadata returns the resource type name.
+func (r *userGroup) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_group"
+}
+
+// Schema defines the schema for the resource.
+func (s *userGroup) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates a MikroTik user group, which defines permissions of its users.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Unique ID of this resource.",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the group.",
+			},
+			"comment": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Comment to the group.",
+			},
+			"policy": schema.SetAttribute{
+				Required:    true,
+				ElementType: tftypes.StringType,
+				Description: "Policies granted to the group, e.g. `local`, `ssh`, `read`, `write`, `policy`, `test`, `winbox`, `web`, `api` or `sensitive`. Policies not listed are denied.",
+			},
+			"skin": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Name of the WebFig skin used by the group.",
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *userGroup) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var terraformModel userGroupModel
+	var mikrotikModel client.UserGroup
+	GenericCreateResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *userGroup) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var terraformModel userGroupModel
+	var mikrotikModel client.UserGroup
+	GenericReadResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *userGroup) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var terraformModel userGroupModel
+	var mikrotikModel client.UserGroup
+	GenericUpdateResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *userGroup) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var terraformModel userGroupModel
+	var mikrotikModel client.UserGroup
+	GenericDeleteResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+func (r *userGroup) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
+
+type userGroupModel struct {
+	Id      tftypes.String `tfsdk:"id"`
+	Name    tftypes.String `tfsdk:"name"`
+	Comment tftypes.String `tfsdk:"comment"`
+	Policy  tftypes.Set    `tfsdk:"policy"`
+	Skin    tftypes.String `tfsdk:"skin"`
+}
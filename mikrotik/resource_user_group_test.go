@@ -0,0 +1,76 @@
+package mikrotik
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var terraformResourceTypeUserGroup string = "mikrotik_user_group"
+
+func TestUserGroup_basic(t *testing.T) {
+	resourceName := terraformResourceTypeUserGroup + ".testacc"
+	name := "testacc-" + client.RandomString()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUserGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserGroupConfig(name, `["read", "winbox"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "policy.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "policy.*", "read"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "policy.*", "winbox"),
+				),
+			},
+			{
+				Config: testAccUserGroupConfig(name, `["read", "ssh", "test"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "policy.#", "3"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "policy.*", "ssh"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     name,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckUserGroupDestroy(s *terraform.State) error {
+	c := client.NewClient(client.GetConfigFromEnv())
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != terraformResourceTypeUserGroup {
+			continue
+		}
+
+		remoteRecord, err := c.FindUserGroup(rs.Primary.Attributes["name"])
+		if err != nil && !client.IsNotFoundError(err) {
+			return fmt.Errorf("expected not found error, got %+#v", err)
+		}
+
+		if remoteRecord != nil {
+			return fmt.Errorf("resource %T with id %q still exists in remote system", remoteRecord, remoteRecord.Id)
+		}
+	}
+	return nil
+}
+
+func testAccUserGroupConfig(name, policy string) string {
+	return fmt.Sprintf(`
+		resource "mikrotik_user_group" "testacc" {
+			name   = %q
+			policy = %s
+		}
+	`, name, policy)
+}
@@ -0,0 +1,142 @@
+package mikrotik
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type userSshKey struct {
+	client *client.Mikrotik
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &userSshKey{}
+	_ resource.ResourceWithConfigure   = &userSshKey{}
+	_ resource.ResourceWithImportState = &userSshKey{}
+)
+
+// NewUserSshKeyResource is a helper function to simplify the provider implementation.
+func NewUserSshKeyResource() resource.Resource {
+	return &userSshKey{}
+}
+
+func (r *userSshKey) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*client.Mikrotik)
+}
+
+// Metadata returns the resource type name.
+func (r *userSshKey) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_ssh_key"
+}
+
+// Schema defines the schema for the resource.
+func (s *userSshKey) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Adds a public SSH key for a MikroTik user. Only supported by RouterOS v7.7+.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Unique ID of this resource.",
+			},
+			"bits": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Description: "Length of the key in bits.",
+			},
+			"key": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Public key in OpenSSH format, e.g. the contents of `~/.ssh/id_rsa.pub`. " +
+					"RouterOS does not report the key back, so it is set on import only if given in the import ID.",
+			},
+			"key_owner": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Comment of the public key, usually identifying its owner.",
+			},
+			"user": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Name of the user the key belongs to.",
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *userSshKey) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var terraformModel userSshKeyModel
+	var mikrotikModel client.UserSshKey
+	GenericCreateResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+	resp.Diagnostics.Append(keepWriteOnlyAttribute(ctx, path.Root("key"), req.Plan, &resp.State)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *userSshKey) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var terraformModel userSshKeyModel
+	var mikrotikModel client.UserSshKey
+	GenericReadResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+	resp.Diagnostics.Append(keepWriteOnlyAttribute(ctx, path.Root("key"), req.State, &resp.State)...)
+}
+
+// Update is never called, since any change of the key requires its replacement.
+func (r *userSshKey) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError("Update is not supported", "SSH keys cannot be modified, they must be replaced.")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *userSshKey) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var terraformModel userSshKeyModel
+	var mikrotikModel client.UserSshKey
+	GenericDeleteResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+// ImportState imports the key by its ID, optionally followed by colon and the public key.
+// RouterOS does not report the key back, so it can only be set in the state from the import ID.
+func (r *userSshKey) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, key, _ := strings.Cut(req.ID, ":")
+	if id == "" {
+		resp.Diagnostics.AddError("Invalid import ID", fmt.Sprintf("expected ID in form of 'id' or 'id:public_key', got %q", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), strings.ToUpper(id))...)
+	if key != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("key"), strings.TrimSpace(key))...)
+	}
+}
+
+type userSshKeyModel struct {
+	Id       tftypes.String `tfsdk:"id"`
+	Bits     tftypes.Int64  `tfsdk:"bits"`
+	Key      tftypes.String `tfsdk:"key"`
+	KeyOwner tftypes.String `tfsdk:"key_owner"`
+	User     tftypes.String `tfsdk:"user"`
+}
@@ -0,0 +1,78 @@
+package mikrotik
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var terraformResourceTypeUserSshKey string = "mikrotik_user_ssh_key"
+
+const testAccUserSshPublicKey = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQDnoaswnny9g0N3oWRcS91aXDKLm8XuRPZpq2VBPf1PH9aapPbyHo0Xz7oYzs0oh1yfY6kEpNLMhvZWYWD7pJs+XruKcSQFHXBUnyZGjV3iwy2Xs/bPfOyqCQBoe4wfqEEnTxMwJv51zUi70XW8aWztWT/6scu77dKSVFc6jPFsGmUxB26gzYxjArgu0uBIkTSMFUcd/n6u3+YshNIXp56jLGt6glkePt7S9CmirUTgUIaZMC83tZrbJCBt8JfMdWwUPvjhH88H+U+NL52a30sU6f+wGYmiu84UWgri5kgxk80tTf/M00sRBxzz9X/8sj4C3iVJMTdkamKb54Fla+iV testacc@example.com"
+
+func TestUserSshKey_basic(t *testing.T) {
+	client.SkipIfRouterOSV6OrEarlier(t, sysResources)
+	resourceName := terraformResourceTypeUserSshKey + ".testacc"
+	name := "testacc-" + client.RandomString()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUserSshKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserSshKeyConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "user", name),
+					resource.TestCheckResourceAttr(resourceName, "bits", "2048"),
+					resource.TestCheckResourceAttr(resourceName, "key_owner", "testacc@example.com"),
+				),
+			},
+			{
+				ResourceName: resourceName,
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return s.RootModule().Resources[resourceName].Primary.ID + ":" + testAccUserSshPublicKey, nil
+				},
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckUserSshKeyDestroy(s *terraform.State) error {
+	c := client.NewClient(client.GetConfigFromEnv())
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != terraformResourceTypeUserSshKey {
+			continue
+		}
+
+		remoteRecord, err := c.FindUserSshKey(rs.Primary.ID)
+		if err != nil && !client.IsNotFoundError(err) {
+			return fmt.Errorf("expected not found error, got %+#v", err)
+		}
+
+		if remoteRecord != nil {
+			return fmt.Errorf("resource %T with id %q still exists in remote system", remoteRecord, remoteRecord.Id)
+		}
+	}
+	return nil
+}
+
+func testAccUserSshKeyConfig(name string) string {
+	return fmt.Sprintf(`
+		resource "mikrotik_user" "testacc" {
+			name  = %q
+			group = "read"
+		}
+
+		resource "mikrotik_user_ssh_key" "testacc" {
+			user = mikrotik_user.testacc.name
+			key  = %q
+		}
+	`, name, testAccUserSshPublicKey)
+}
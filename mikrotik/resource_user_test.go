@@ -0,0 +1,112 @@
+package mikrotik
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var terraformResourceTypeUser string = "mikrotik_user"
+
+func TestUser_basic(t *testing.T) {
+	resourceName := terraformResourceTypeUser + ".testacc"
+	name := "testacc-" + client.RandomString()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserConfig(name, "read", "first-Secret1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "group", "read"),
+					resource.TestCheckResourceAttr(resourceName, "password", "first-Secret1"),
+					resource.TestCheckResourceAttr(resourceName, "address.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "address.*", "10.0.0.0/8"),
+				),
+			},
+			{
+				Config: testAccUserConfig(name, "write", "second-Secret2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "group", "write"),
+					resource.TestCheckResourceAttr(resourceName, "password", "second-Secret2"),
+				),
+			},
+			{
+				Config: testAccUserConfigWithoutAddress(name, "write", "second-Secret2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "address.#", "0"),
+					testAccCheckUserAddressCleared(name),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateId:           name,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
+
+func testAccCheckUserDestroy(s *terraform.State) error {
+	c := client.NewClient(client.GetConfigFromEnv())
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != terraformResourceTypeUser {
+			continue
+		}
+
+		remoteRecord, err := c.FindUser(rs.Primary.Attributes["name"])
+		if err != nil && !client.IsNotFoundError(err) {
+			return fmt.Errorf("expected not found error, got %+#v", err)
+		}
+
+		if remoteRecord != nil {
+			return fmt.Errorf("resource %T with id %q still exists in remote system", remoteRecord, remoteRecord.Id)
+		}
+	}
+	return nil
+}
+
+func testAccUserConfig(name, group, password string) string {
+	return fmt.Sprintf(`
+		resource "mikrotik_user" "testacc" {
+			name     = %q
+			group    = %q
+			password = %q
+			address  = ["10.0.0.0/8"]
+		}
+	`, name, group, password)
+}
+
+func testAccUserConfigWithoutAddress(name, group, password string) string {
+	return fmt.Sprintf(`
+		resource "mikrotik_user" "testacc" {
+			name     = %q
+			group    = %q
+			password = %q
+		}
+	`, name, group, password)
+}
+
+func testAccCheckUserAddressCleared(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		c := client.NewClient(client.GetConfigFromEnv())
+		user, err := c.FindUser(name)
+		if err != nil {
+			return err
+		}
+		if len(user.Address) != 0 {
+			return fmt.Errorf("expected no allowed addresses, got %v", user.Address)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,122 @@
+package mikrotik
+
+import (
+	"context"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type vrf struct {
+	client *client.Mikrotik
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &vrf{}
+	_ resource.ResourceWithConfigure   = &vrf{}
+	_ resource.ResourceWithImportState = &vrf{}
+)
+
+// NewVrfResource is a helper function to simplify the provider implementation.
+func NewVrfResource() resource.Resource {
+	return &vrf{}
+}
+
+func (r *vrf) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*client.Mikrotik)
+}
+
+// Metadata returns the resource type name.
+func (r *vrf) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vrf"
+}
+
+// Schema defines the schema for the resource.
+func (s *vrf) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates a MikroTik VRF (virtual routing and forwarding instance) only supported by RouterOS v7+. " +
+			"RouterOS creates a routing table with the same name, which can be referenced by routes and routing protocols.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Unique ID of this resource.",
+			},
+			"comment": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Comment to the VRF.",
+			},
+			"disabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the VRF is disabled.",
+			},
+			"interfaces": schema.SetAttribute{
+				Required:    true,
+				ElementType: tftypes.StringType,
+				Description: "Interfaces assigned to the VRF. Addresses of these interfaces are placed into the VRF routing table.",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the VRF and its routing table.",
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *vrf) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var terraformModel vrfModel
+	var mikrotikModel client.Vrf
+	GenericCreateResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *vrf) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var terraformModel vrfModel
+	var mikrotikModel client.Vrf
+	GenericReadResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *vrf) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var terraformModel vrfModel
+	var mikrotikModel client.Vrf
+	GenericUpdateResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *vrf) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var terraformModel vrfModel
+	var mikrotikModel client.Vrf
+	GenericDeleteResource(&terraformModel, &mikrotikModel, r.client)(ctx, req, resp)
+}
+
+func (r *vrf) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
+
+type vrfModel struct {
+	Id         tftypes.String `tfsdk:"id"`
+	Comment    tftypes.String `tfsdk:"comment"`
+	Disabled   tftypes.Bool   `tfsdk:"disabled"`
+	Interfaces tftypes.Set    `tfsdk:"interfaces"`
+	Name       tftypes.String `tfsdk:"name"`
+}
@@ -0,0 +1,85 @@
+package mikrotik
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ddelnano/terraform-provider-mikrotik/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var terraformResourceTypeVrf string = "mikrotik_vrf"
+
+func TestVrf_basic(t *testing.T) {
+	client.SkipIfRouterOSV6OrEarlier(t, sysResources)
+	resourceName := terraformResourceTypeVrf + ".testacc"
+	name := "testacc-" + client.RandomString()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVrfDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVrfConfig(name, `[mikrotik_bridge.a.name]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "interfaces.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "interfaces.*", name+"-a"),
+				),
+			},
+			{
+				Config: testAccVrfConfig(name, `[mikrotik_bridge.b.name, mikrotik_bridge.a.name]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "interfaces.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "interfaces.*", name+"-a"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "interfaces.*", name+"-b"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     name,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckVrfDestroy(s *terraform.State) error {
+	c := client.NewClient(client.GetConfigFromEnv())
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != terraformResourceTypeVrf {
+			continue
+		}
+
+		remoteRecord, err := c.FindVrf(rs.Primary.Attributes["name"])
+		if err != nil && !client.IsNotFoundError(err) {
+			return fmt.Errorf("expected not found error, got %+#v", err)
+		}
+
+		if remoteRecord != nil {
+			return fmt.Errorf("resource %T with id %q still exists in remote system", remoteRecord, remoteRecord.Id)
+		}
+	}
+	return nil
+}
+
+func testAccVrfConfig(name, interfaces string) string {
+	return fmt.Sprintf(`
+		resource "mikrotik_bridge" "a" {
+			name = "%[1]s-a"
+		}
+
+		resource "mikrotik_bridge" "b" {
+			name = "%[1]s-b"
+		}
+
+		resource "mikrotik_vrf" "testacc" {
+			name       = %[1]q
+			interfaces = %[2]s
+		}
+	`, name, interfaces)
+}